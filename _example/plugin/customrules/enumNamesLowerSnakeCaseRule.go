@@ -33,6 +33,20 @@ func (r EnumNamesLowerSnakeCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule, which can be used in add_categories of .protolint.yaml.
+func (r EnumNamesLowerSnakeCaseRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule, which `protolint explain` shows.
+func (r EnumNamesLowerSnakeCaseRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Enum names must be written in lower_snake_case.",
+		GoodExample: `enum foo_bar {}`,
+		BadExample:  `enum FooBar {}`,
+	}
+}
+
 // Severity gets the severity of the rule
 func (r EnumNamesLowerSnakeCaseRule) Severity() rule.Severity {
 	return rule.SeverityWarning
@@ -7,6 +7,7 @@ require (
 	github.com/golang/protobuf v1.5.2
 	github.com/hashicorp/go-hclog v1.2.0
 	github.com/hashicorp/go-plugin v1.4.3
+	github.com/tetratelabs/wazero v1.7.3
 	github.com/yoheimuta/go-protoparser/v4 v4.10.0
 	google.golang.org/grpc v1.46.0
 	google.golang.org/protobuf v1.27.1
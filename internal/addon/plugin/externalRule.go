@@ -1,37 +1,69 @@
 package plugin
 
 import (
-	"path/filepath"
-
 	"github.com/yoheimuta/protolint/internal/addon/plugin/shared"
 
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
 
 	"github.com/yoheimuta/protolint/internal/addon/plugin/proto"
+	"github.com/yoheimuta/protolint/internal/linter/file"
 	"github.com/yoheimuta/protolint/linter/report"
 	"github.com/yoheimuta/protolint/linter/rule"
 )
 
+// PluginErrorRuleID is the rule ID of a failure reporting a plugin error.
+const PluginErrorRuleID = "PLUGIN_ERROR"
+
 // externalRule represents a customized rule that works as a plugin.
 type externalRule struct {
-	id       string
-	purpose  string
-	client   shared.RuleSet
-	severity rule.Severity
+	id               string
+	purpose          string
+	client           shared.RuleSet
+	severity         rule.Severity
+	isDefault        bool
+	categories       []string
+	documentationURL string
+	deterministic    bool
+	metadata         rule.Metadata
+	errorAsFailure   bool
 }
 
 func newExternalRule(
-	id string,
-	purpose string,
+	meta *proto.ListRulesResponse_Rule,
 	client shared.RuleSet,
-	severity rule.Severity,
+	errorAsFailure bool,
 ) externalRule {
+	isDefault := true
+	if meta.IsDefault != nil {
+		isDefault = *meta.IsDefault
+	}
+	var options []rule.Option
+	for _, o := range meta.Options {
+		options = append(options, rule.Option{
+			Name:        o.Name,
+			Default:     o.Default,
+			Description: o.Description,
+		})
+	}
 	return externalRule{
-		id:       id,
-		purpose:  purpose,
-		client:   client,
-		severity: severity,
+		id:               meta.Id,
+		purpose:          meta.Purpose,
+		client:           client,
+		severity:         getSeverity(meta.Severity),
+		isDefault:        isDefault,
+		categories:       meta.Categories,
+		documentationURL: meta.DocumentationUrl,
+		deterministic:    meta.Deterministic,
+		metadata: rule.Metadata{
+			Description:     meta.Description,
+			GoodExample:     meta.GoodExample,
+			BadExample:      meta.BadExample,
+			Options:         options,
+			Fixable:         meta.Fixable,
+			AutoDisableable: meta.AutoDisableable,
+		},
+		errorAsFailure: errorAsFailure,
 	}
 }
 
@@ -45,9 +77,31 @@ func (r externalRule) Purpose() string {
 	return r.purpose
 }
 
-// IsOfficial decides whether or not this rule belongs to the official guide.
+// IsOfficial decides whether or not this rule belongs to the default set.
+// The plugin decides it. It's true when the plugin doesn't tell.
 func (r externalRule) IsOfficial() bool {
-	return true
+	return r.isDefault
+}
+
+// Categories returns the categories of this rule.
+func (r externalRule) Categories() []string {
+	return r.categories
+}
+
+// DocumentationURL returns the URL of the documentation of this rule.
+func (r externalRule) DocumentationURL() string {
+	return r.documentationURL
+}
+
+// IsDeterministic decides whether or not the results of this rule can be cached.
+// The plugin decides it. It's false when the plugin doesn't tell.
+func (r externalRule) IsDeterministic() bool {
+	return r.deterministic
+}
+
+// Metadata returns the details of this rule the plugin tells.
+func (r externalRule) Metadata() rule.Metadata {
+	return r.metadata
 }
 
 // Severity returns the severity of a rule (note, warning, error)
@@ -58,27 +112,59 @@ func (r externalRule) Severity() rule.Severity {
 // Apply applies the rule to the proto.
 func (r externalRule) Apply(p *parser.Proto) ([]report.Failure, error) {
 	relPath := p.Meta.Filename
-	absPath, err := filepath.Abs(relPath)
-	if err != nil {
-		return nil, err
-	}
+	f := file.FromProto(p)
 
-	resp, err := r.client.Apply(&proto.ApplyRequest{
+	req := &proto.ApplyRequest{
 		Id:   r.id,
-		Path: absPath,
-	})
+		Path: f.Path(),
+	}
+	if f.IsInMemory() {
+		content, err := f.Content()
+		if err != nil {
+			return nil, err
+		}
+		req.Content = content
+	}
+	resp, err := r.client.Apply(req)
 	if err != nil {
+		if r.errorAsFailure {
+			return []report.Failure{
+				report.Failuref(meta.Position{Filename: relPath}, PluginErrorRuleID, "%s", err),
+			}, nil
+		}
 		return nil, err
 	}
 
+	if 0 < len(resp.FixedContent) {
+		err = f.WriteContent(resp.FixedContent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var fs []report.Failure
 	for _, f := range resp.Failures {
-		fs = append(fs, report.FailureWithSeverityf(meta.Position{
-			Filename: relPath,
-			Offset:   int(f.Pos.Offset),
-			Line:     int(f.Pos.Line),
-			Column:   int(f.Pos.Column),
-		}, r.id, string(r.severity), f.Message))
+		failure := report.FailureWithSeverityf(newPosition(relPath, f.Pos), r.id, string(r.severity), f.Message)
+		if f.End != nil {
+			failure = failure.WithEnd(newPosition(relPath, f.End))
+		}
+		var locations []report.Location
+		for _, l := range f.SecondaryLocations {
+			locations = append(locations, report.Location{
+				Pos:     newPosition(relPath, l.Pos),
+				Message: l.Message,
+			})
+		}
+		fs = append(fs, failure.WithSecondaryLocations(locations...))
 	}
 	return fs, nil
 }
+
+func newPosition(filename string, pos *proto.ApplyResponse_Position) meta.Position {
+	return meta.Position{
+		Filename: filename,
+		Offset:   int(pos.GetOffset()),
+		Line:     int(pos.GetLine()),
+		Column:   int(pos.GetColumn()),
+	}
+}
@@ -8,10 +8,14 @@ import (
 )
 
 // GetExternalRules provides the external rules.
+// If errorAsFailure is true, the rules report a plugin error as a PLUGIN_ERROR failure instead of returning it.
+// A non-empty fixOnly limits the fix mode to the rules with the IDs.
 func GetExternalRules(
 	clients []shared.RuleSet,
 	fixMode bool,
+	fixOnly []string,
 	verbose bool,
+	errorAsFailure bool,
 ) ([]rule.Rule, error) {
 	var rs []rule.Rule
 
@@ -19,14 +23,14 @@ func GetExternalRules(
 		resp, err := client.ListRules(&proto.ListRulesRequest{
 			Verbose: verbose,
 			FixMode: fixMode,
+			FixOnly: fixOnly,
 		})
 		if err != nil {
 			return nil, err
 		}
 
 		for _, r := range resp.Rules {
-			severity := getSeverity(r.Severity)
-			rs = append(rs, newExternalRule(r.Id, r.Purpose, client, severity))
+			rs = append(rs, newExternalRule(r, client, errorAsFailure))
 		}
 	}
 	return rs, nil
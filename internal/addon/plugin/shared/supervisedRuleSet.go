@@ -0,0 +1,208 @@
+package shared
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/proto"
+)
+
+// Process represents a running plugin.
+type Process interface {
+	// Exited checks whether the plugin has exited.
+	Exited() bool
+	// Kill stops the plugin.
+	Kill()
+}
+
+// StartFunc starts a plugin.
+type StartFunc func() (RuleSet, Process, error)
+
+// SupervisedRuleSet is the implementation of RuleSet that isolates protolint from a faulty plugin.
+// It bounds each call by the timeout, and restarts the plugin on the next call after it crashed or hung.
+type SupervisedRuleSet struct {
+	command string
+	timeout time.Duration
+	start   StartFunc
+
+	mu               sync.Mutex
+	ruleSet          RuleSet
+	process          Process
+	listRulesRequest *proto.ListRulesRequest
+}
+
+// NewSupervisedRuleSet starts the plugin and creates a new SupervisedRuleSet.
+// The command identifies the plugin in errors. A timeout of zero means no timeout.
+func NewSupervisedRuleSet(
+	command string,
+	timeout time.Duration,
+	start StartFunc,
+) (*SupervisedRuleSet, error) {
+	s := &SupervisedRuleSet{
+		command: command,
+		timeout: timeout,
+		start:   start,
+	}
+	if err := s.restart(); err != nil {
+		return nil, s.newError("", err)
+	}
+	return s, nil
+}
+
+// Command returns the plugin command specified with the -plugin flag.
+func (s *SupervisedRuleSet) Command() string {
+	return s.command
+}
+
+// ListRules returns all supported rules metadata.
+func (s *SupervisedRuleSet) ListRules(req *proto.ListRulesRequest) (*proto.ListRulesResponse, error) {
+	var resp *proto.ListRulesResponse
+	err := s.do("", func(r RuleSet) error {
+		var err error
+		resp, err = r.ListRules(req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.listRulesRequest = req
+	s.mu.Unlock()
+	return resp, nil
+}
+
+// Apply applies the rule to the proto.
+func (s *SupervisedRuleSet) Apply(req *proto.ApplyRequest) (*proto.ApplyResponse, error) {
+	var resp *proto.ApplyResponse
+	err := s.do(req.Id, func(r RuleSet) error {
+		var err error
+		resp, err = r.Apply(req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Kill stops the plugin.
+func (s *SupervisedRuleSet) Kill() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.process != nil {
+		s.kill()
+	}
+}
+
+func (s *SupervisedRuleSet) do(
+	ruleID string,
+	call func(RuleSet) error,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.process == nil {
+		if err := s.restart(); err != nil {
+			return s.newError(ruleID, err)
+		}
+	}
+
+	if err := s.invoke(call); err != nil {
+		return s.newError(ruleID, err)
+	}
+	return nil
+}
+
+// restart starts the plugin and replays the last ListRules,
+// because the plugin initializes its rules on ListRules.
+func (s *SupervisedRuleSet) restart() error {
+	ruleSet, process, err := s.start()
+	if err != nil {
+		return err
+	}
+	s.ruleSet = ruleSet
+	s.process = process
+
+	if s.listRulesRequest == nil {
+		return nil
+	}
+	req := s.listRulesRequest
+	return s.invoke(func(r RuleSet) error {
+		_, err := r.ListRules(req)
+		return err
+	})
+}
+
+// invoke runs the call within the timeout.
+// It kills the plugin when the call times out or the plugin crashes, so that the next call restarts it.
+func (s *SupervisedRuleSet) invoke(
+	call func(RuleSet) error,
+) error {
+	done := make(chan error, 1)
+	ruleSet := s.ruleSet
+	go func() {
+		done <- call(ruleSet)
+	}()
+
+	var timeout <-chan time.Time
+	if 0 < s.timeout {
+		timer := time.NewTimer(s.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case err := <-done:
+		if err != nil && (s.process.Exited() || status.Code(err) == codes.Unavailable) {
+			s.kill()
+		}
+		return err
+	case <-timeout:
+		s.kill()
+		return fmt.Errorf("timed out after %s", s.timeout)
+	}
+}
+
+func (s *SupervisedRuleSet) kill() {
+	s.process.Kill()
+	s.ruleSet = nil
+	s.process = nil
+}
+
+func (s *SupervisedRuleSet) newError(
+	ruleID string,
+	err error,
+) error {
+	return PluginError{
+		Command: s.command,
+		RuleID:  ruleID,
+		Err:     err,
+	}
+}
+
+// PluginError represents an error returned through a plugin.
+type PluginError struct {
+	// Command is the plugin command specified with the -plugin flag.
+	Command string
+	// RuleID is the ID of the applied rule. It's empty if the error isn't specific to a rule.
+	RuleID string
+	Err    error
+}
+
+func (e PluginError) Error() string {
+	if e.RuleID == "" {
+		return fmt.Sprintf("plugin %q failed, err=%s", e.Command, e.Err)
+	}
+	return fmt.Sprintf("plugin %q failed to apply rule=%s, err=%s", e.Command, e.RuleID, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e PluginError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,143 @@
+package shared_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/proto"
+	"github.com/yoheimuta/protolint/internal/addon/plugin/shared"
+)
+
+type fakePlugin struct {
+	generation int
+	exited     bool
+	listed     bool
+	behavior   func(generation int) error
+	unblock    chan struct{}
+}
+
+func (p *fakePlugin) ListRules(*proto.ListRulesRequest) (*proto.ListRulesResponse, error) {
+	p.listed = true
+	return &proto.ListRulesResponse{}, nil
+}
+
+func (p *fakePlugin) Apply(req *proto.ApplyRequest) (*proto.ApplyResponse, error) {
+	if !p.listed {
+		return nil, fmt.Errorf("not found rule=%s", req.Id)
+	}
+	if err := p.behavior(p.generation); err != nil {
+		return nil, err
+	}
+	return &proto.ApplyResponse{}, nil
+}
+
+func (p *fakePlugin) Exited() bool {
+	return p.exited
+}
+
+func (p *fakePlugin) Kill() {
+	p.exited = true
+	close(p.unblock)
+}
+
+var errHang = errors.New("hang")
+
+func newFakeStarter(
+	behavior func(generation int) error,
+) (shared.StartFunc, *int) {
+	starts := 0
+	return func() (shared.RuleSet, shared.Process, error) {
+		starts++
+		p := &fakePlugin{
+			generation: starts,
+			unblock:    make(chan struct{}),
+		}
+		p.behavior = func(generation int) error {
+			err := behavior(generation)
+			if err == errHang {
+				<-p.unblock
+				return errors.New("killed")
+			}
+			if err != nil {
+				p.exited = true
+			}
+			return err
+		}
+		return p, p, nil
+	}, &starts
+}
+
+func TestSupervisedRuleSet_Apply(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		behavior   func(generation int) error
+		wantErrs   []string
+		wantStarts int
+	}{
+		{
+			name: "no errors",
+			behavior: func(int) error {
+				return nil
+			},
+			wantErrs:   []string{"", ""},
+			wantStarts: 1,
+		},
+		{
+			name: "restarts the plugin after it crashed",
+			behavior: func(generation int) error {
+				if generation == 1 {
+					return errors.New("crashed")
+				}
+				return nil
+			},
+			wantErrs: []string{
+				`plugin "./plugin" failed to apply rule=RULE, err=crashed`,
+				"",
+			},
+			wantStarts: 2,
+		},
+		{
+			name: "kills and restarts the plugin after it timed out",
+			behavior: func(generation int) error {
+				if generation == 1 {
+					return errHang
+				}
+				return nil
+			},
+			wantErrs: []string{
+				`plugin "./plugin" failed to apply rule=RULE, err=timed out after 10ms`,
+				"",
+			},
+			wantStarts: 2,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			start, starts := newFakeStarter(test.behavior)
+			ruleSet, err := shared.NewSupervisedRuleSet("./plugin", 10*time.Millisecond, start)
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			_, err = ruleSet.ListRules(&proto.ListRulesRequest{})
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+
+			for _, wantErr := range test.wantErrs {
+				_, err := ruleSet.Apply(&proto.ApplyRequest{Id: "RULE"})
+				gotErr := ""
+				if err != nil {
+					gotErr = err.Error()
+				}
+				if gotErr != wantErr {
+					t.Errorf("got err %q, but want %q", gotErr, wantErr)
+				}
+			}
+			if *starts != test.wantStarts {
+				t.Errorf("got %d starts, but want %d", *starts, test.wantStarts)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package wasi
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// RuleSet is the interface that a WASI plugin serves.
+// It's the same as shared.RuleSet, which can't be compiled for WASI.
+type RuleSet interface {
+	ListRules(*proto.ListRulesRequest) (*proto.ListRulesResponse, error)
+	Apply(*proto.ApplyRequest) (*proto.ApplyResponse, error)
+}
+
+// Serve handles request frames read from r until it reaches EOF.
+func Serve(
+	r io.Reader,
+	w io.Writer,
+	ruleSet RuleSet,
+) error {
+	for {
+		method, payload, err := ReadRequest(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp, respErr := handle(ruleSet, method, payload)
+		if err := WriteResponse(w, resp, respErr); err != nil {
+			return err
+		}
+	}
+}
+
+func handle(
+	ruleSet RuleSet,
+	method Method,
+	payload []byte,
+) (protobuf.Message, error) {
+	switch method {
+	case MethodListRules:
+		var req proto.ListRulesRequest
+		if err := protobuf.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return ruleSet.ListRules(&req)
+	case MethodApply:
+		var req proto.ApplyRequest
+		if err := protobuf.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return ruleSet.Apply(&req)
+	default:
+		return nil, fmt.Errorf("unknown method=%d", method)
+	}
+}
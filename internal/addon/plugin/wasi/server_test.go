@@ -0,0 +1,106 @@
+package wasi_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/proto"
+	"github.com/yoheimuta/protolint/internal/addon/plugin/wasi"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+type fakeRuleSet struct{}
+
+func (fakeRuleSet) ListRules(req *proto.ListRulesRequest) (*proto.ListRulesResponse, error) {
+	return &proto.ListRulesResponse{
+		Rules: []*proto.ListRulesResponse_Rule{
+			{
+				Id:       "FAKE",
+				Purpose:  fmt.Sprintf("fixMode=%v", req.FixMode),
+				Severity: proto.RuleSeverity_RULE_SEVERITY_WARNING,
+			},
+		},
+	}, nil
+}
+
+func (fakeRuleSet) Apply(req *proto.ApplyRequest) (*proto.ApplyResponse, error) {
+	if req.Id != "FAKE" {
+		return nil, fmt.Errorf("not found rule=%s", req.Id)
+	}
+	return &proto.ApplyResponse{
+		Failures: []*proto.ApplyResponse_Failure{
+			{
+				Message: string(req.Content),
+				Pos: &proto.ApplyResponse_Position{
+					Line:   1,
+					Column: 2,
+				},
+			},
+		},
+	}, nil
+}
+
+func TestServe(t *testing.T) {
+	requests := &bytes.Buffer{}
+	for _, req := range []struct {
+		method  wasi.Method
+		request protobuf.Message
+	}{
+		{
+			method:  wasi.MethodListRules,
+			request: &proto.ListRulesRequest{FixMode: true},
+		},
+		{
+			method:  wasi.MethodApply,
+			request: &proto.ApplyRequest{Id: "FAKE", Path: "/a.proto", Content: []byte(`syntax = "proto3";`)},
+		},
+		{
+			method:  wasi.MethodApply,
+			request: &proto.ApplyRequest{Id: "UNKNOWN"},
+		},
+	} {
+		err := wasi.WriteRequest(requests, req.method, req.request)
+		if err != nil {
+			t.Fatalf("got err %v, but want nil", err)
+		}
+	}
+
+	responses := &bytes.Buffer{}
+	err := wasi.Serve(requests, responses, fakeRuleSet{})
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+
+	var listResp proto.ListRulesResponse
+	err = wasi.ReadResponse(responses, &listResp)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	if len(listResp.Rules) != 1 || listResp.Rules[0].Purpose != "fixMode=true" {
+		t.Errorf("got %v, but want a FAKE rule with fixMode=true", listResp.Rules)
+	}
+
+	var applyResp proto.ApplyResponse
+	err = wasi.ReadResponse(responses, &applyResp)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	gotMessages := []string{}
+	for _, f := range applyResp.Failures {
+		gotMessages = append(gotMessages, f.Message)
+	}
+	if !reflect.DeepEqual(gotMessages, []string{`syntax = "proto3";`}) {
+		t.Errorf("got %v, but want the content", gotMessages)
+	}
+
+	err = wasi.ReadResponse(responses, &applyResp)
+	if err == nil || err.Error() != "not found rule=UNKNOWN" {
+		t.Errorf("got err %v, but want not found rule=UNKNOWN", err)
+	}
+
+	if responses.Len() != 0 {
+		t.Errorf("got %d unread bytes, but want 0", responses.Len())
+	}
+}
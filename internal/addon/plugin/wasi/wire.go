@@ -0,0 +1,132 @@
+// Package wasi implements the protocol between protolint and plugins compiled to WASI modules.
+//
+// A WASI plugin can't be reached over gRPC, so the same ListRules and Apply messages
+// are exchanged as frames over the module's stdin and stdout instead.
+// Each frame consists of a one-byte header, a four-byte big-endian payload length and the payload.
+// The header of a request is the Method. The header of a response is a status,
+// and the payload is either the marshaled response message or an error message.
+package wasi
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Method represents a method of the RuleSetService.
+type Method byte
+
+// Method constants.
+const (
+	MethodListRules Method = iota + 1
+	MethodApply
+)
+
+const (
+	statusOK byte = iota
+	statusError
+)
+
+// maxPayloadSize protects the reader against a corrupted length.
+const maxPayloadSize = 1 << 30
+
+// WriteRequest writes the request frame of the method to w.
+func WriteRequest(
+	w io.Writer,
+	method Method,
+	req proto.Message,
+) error {
+	payload, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return writeFrame(w, byte(method), payload)
+}
+
+// ReadRequest reads a request frame from r.
+// It returns io.EOF when r has no more frames.
+func ReadRequest(
+	r io.Reader,
+) (Method, []byte, error) {
+	header, payload, err := readFrame(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	return Method(header), payload, nil
+}
+
+// WriteResponse writes the response frame to w.
+// If respErr is not nil, the frame carries the error instead of resp.
+func WriteResponse(
+	w io.Writer,
+	resp proto.Message,
+	respErr error,
+) error {
+	if respErr != nil {
+		return writeFrame(w, statusError, []byte(respErr.Error()))
+	}
+
+	payload, err := proto.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	return writeFrame(w, statusOK, payload)
+}
+
+// ReadResponse reads a response frame from r and unmarshals it into resp.
+func ReadResponse(
+	r io.Reader,
+	resp proto.Message,
+) error {
+	status, payload, err := readFrame(r)
+	if err != nil {
+		return err
+	}
+	switch status {
+	case statusOK:
+		return proto.Unmarshal(payload, resp)
+	case statusError:
+		return errors.New(string(payload))
+	default:
+		return fmt.Errorf("unknown response status=%d", status)
+	}
+}
+
+func writeFrame(
+	w io.Writer,
+	header byte,
+	payload []byte,
+) error {
+	frame := make([]byte, 5+len(payload))
+	frame[0] = header
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(payload)))
+	copy(frame[5:], payload)
+
+	_, err := w.Write(frame)
+	return err
+}
+
+func readFrame(
+	r io.Reader,
+) (byte, []byte, error) {
+	var prefix [5]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return 0, nil, err
+	}
+
+	size := binary.BigEndian.Uint32(prefix[1:5])
+	if maxPayloadSize < size {
+		return 0, nil, fmt.Errorf("too large frame payload, size=%d", size)
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, err
+	}
+	return prefix[0], payload, nil
+}
@@ -0,0 +1,194 @@
+// Package wasm runs plugins compiled to WASI modules in a sandboxed WebAssembly runtime.
+package wasm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/proto"
+	"github.com/yoheimuta/protolint/internal/addon/plugin/wasi"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+var (
+	clientsMu sync.Mutex
+	clients   []*Client
+)
+
+// Client is the implementation of RuleSet that talks to a WASI module.
+//
+// The module is sandboxed. It has no access to the filesystem, the network and the environment variables.
+// Therefore, Apply passes the file content to the module instead of letting it read the path.
+type Client struct {
+	path    string
+	runtime wazero.Runtime
+	cancel  context.CancelFunc
+
+	mu     sync.Mutex
+	stdin  *io.PipeWriter
+	stdout *io.PipeReader
+	done   chan struct{}
+}
+
+// NewClient compiles the module at path and starts it.
+func NewClient(
+	path string,
+	verbose bool,
+) (*Client, error) {
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeConfig := wazero.NewRuntimeConfig().WithCloseOnContextDone(true)
+	if cache, err := newCompilationCache(); err == nil {
+		runtimeConfig = runtimeConfig.WithCompilationCache(cache)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runtime := wazero.NewRuntimeWithConfig(ctx, runtimeConfig)
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		cancel()
+		_ = runtime.Close(context.Background())
+		return nil, err
+	}
+
+	compiled, err := runtime.CompileModule(ctx, code)
+	if err != nil {
+		cancel()
+		_ = runtime.Close(context.Background())
+		return nil, fmt.Errorf("failed to compile %s, err=%s", path, err)
+	}
+
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+	var stderr io.Writer = io.Discard
+	if verbose {
+		stderr = os.Stderr
+	}
+	config := wazero.NewModuleConfig().
+		WithName(filepath.Base(path)).
+		WithArgs(filepath.Base(path)).
+		WithStdin(stdinReader).
+		WithStdout(stdoutWriter).
+		WithStderr(stderr).
+		WithSysNanosleep()
+
+	c := &Client{
+		path:    path,
+		runtime: runtime,
+		cancel:  cancel,
+		stdin:   stdinWriter,
+		stdout:  stdoutReader,
+		done:    make(chan struct{}),
+	}
+	go func() {
+		_, err := runtime.InstantiateModule(ctx, compiled, config)
+		if exitErr, ok := err.(*sys.ExitError); ok && exitErr.ExitCode() == 0 {
+			err = nil
+		}
+		if err == nil {
+			err = fmt.Errorf("plugin %s exited", path)
+		}
+
+		// Mark it exited before a pending call sees the error.
+		close(c.done)
+		_ = stdoutWriter.CloseWithError(err)
+	}()
+
+	clientsMu.Lock()
+	clients = append(clients, c)
+	clientsMu.Unlock()
+	return c, nil
+}
+
+// ListRules returns all supported rules metadata.
+func (c *Client) ListRules(req *proto.ListRulesRequest) (*proto.ListRulesResponse, error) {
+	var resp proto.ListRulesResponse
+	if err := c.call(wasi.MethodListRules, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Apply applies the rule to the proto.
+func (c *Client) Apply(req *proto.ApplyRequest) (*proto.ApplyResponse, error) {
+	if len(req.Content) == 0 {
+		content, err := os.ReadFile(req.Path)
+		if err != nil {
+			return nil, err
+		}
+		req = &proto.ApplyRequest{
+			Id:      req.Id,
+			Path:    req.Path,
+			Content: content,
+		}
+	}
+
+	var resp proto.ApplyResponse
+	if err := c.call(wasi.MethodApply, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) call(
+	method wasi.Method,
+	req protobuf.Message,
+	resp protobuf.Message,
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := wasi.WriteRequest(c.stdin, method, req); err != nil {
+		return fmt.Errorf("failed to send a request to plugin %s, err=%s", c.path, err)
+	}
+	return wasi.ReadResponse(c.stdout, resp)
+}
+
+// Exited checks whether the module has exited.
+func (c *Client) Exited() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Kill stops the module and releases the runtime.
+func (c *Client) Kill() {
+	_ = c.stdin.Close()
+	c.cancel()
+	<-c.done
+	_ = c.runtime.Close(context.Background())
+}
+
+// newCompilationCache returns the cache shared across runs,
+// because compiling a module can take much longer than linting.
+func newCompilationCache() (wazero.CompilationCache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	return wazero.NewCompilationCacheWithDir(filepath.Join(dir, "protolint", "wazero"))
+}
+
+// CleanupClients kills all the started clients.
+func CleanupClients() {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	for _, c := range clients {
+		c.Kill()
+	}
+	clients = nil
+}
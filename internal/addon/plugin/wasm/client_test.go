@@ -0,0 +1,175 @@
+package wasm_test
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/proto"
+	"github.com/yoheimuta/protolint/internal/addon/plugin/wasm"
+)
+
+// echoModulePath is the path of testdata/echo compiled to a WASI module. It's empty if the build failed.
+var echoModulePath string
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "protolint-wasm")
+	if err != nil {
+		panic(err)
+	}
+
+	path := filepath.Join(dir, "echo.wasm")
+	cmd := exec.Command("go", "build", "-o", path, "./testdata/echo")
+	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
+	if out, err := cmd.CombinedOutput(); err == nil {
+		echoModulePath = path
+	} else {
+		fmt.Fprintf(os.Stderr, "failed to build the module, err=%s, out=%s\n", err, out)
+	}
+
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func echoModule(t *testing.T) string {
+	t.Helper()
+	if echoModulePath == "" {
+		t.Skip("skip because the module can't be built")
+	}
+	return echoModulePath
+}
+
+func TestClient_ListRules(t *testing.T) {
+	c, err := wasm.NewClient(echoModule(t), false)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	defer c.Kill()
+
+	resp, err := c.ListRules(&proto.ListRulesRequest{})
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	if len(resp.Rules) != 1 || resp.Rules[0].Id != "ECHO" {
+		t.Errorf("got %v, but want the ECHO rule", resp.Rules)
+	}
+}
+
+func TestClient_Apply(t *testing.T) {
+	modulePath := echoModule(t)
+	hostPath := filepath.Join(t.TempDir(), "host.proto")
+	err := os.WriteFile(hostPath, []byte("from host"), 0644)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+
+	for _, test := range []struct {
+		name         string
+		inputReq     *proto.ApplyRequest
+		wantMessage  string
+		wantExistErr bool
+	}{
+		{
+			name: "the content in the request is passed to the module",
+			inputReq: &proto.ApplyRequest{
+				Id:      "ECHO",
+				Path:    hostPath,
+				Content: []byte("from request"),
+			},
+			wantMessage: "from request",
+		},
+		{
+			name: "the host reads the file when the content is empty",
+			inputReq: &proto.ApplyRequest{
+				Id:   "ECHO",
+				Path: hostPath,
+			},
+			wantMessage: "from host",
+		},
+		{
+			name: "the host fails to read the missing file",
+			inputReq: &proto.ApplyRequest{
+				Id:   "ECHO",
+				Path: filepath.Join(t.TempDir(), "missing.proto"),
+			},
+			wantExistErr: true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			c, err := wasm.NewClient(modulePath, false)
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			defer c.Kill()
+
+			resp, err := c.Apply(test.inputReq)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			if len(resp.Failures) != 1 || resp.Failures[0].Message != test.wantMessage {
+				t.Errorf("got %v, but want a failure with %q", resp.Failures, test.wantMessage)
+			}
+		})
+	}
+}
+
+func TestClient_Exited(t *testing.T) {
+	modulePath := echoModule(t)
+
+	t.Run("the module exits on its own", func(t *testing.T) {
+		c, err := wasm.NewClient(modulePath, false)
+		if err != nil {
+			t.Fatalf("got err %v, but want nil", err)
+		}
+		defer c.Kill()
+
+		if c.Exited() {
+			t.Fatalf("got exited, but want running")
+		}
+		_, err = c.Apply(&proto.ApplyRequest{Id: "EXIT", Content: []byte("x")})
+		if err == nil {
+			t.Errorf("got err nil, but want err")
+		}
+		if !waitExited(c) {
+			t.Errorf("got running, but want exited")
+		}
+	})
+
+	t.Run("Kill stops the module", func(t *testing.T) {
+		c, err := wasm.NewClient(modulePath, false)
+		if err != nil {
+			t.Fatalf("got err %v, but want nil", err)
+		}
+
+		c.Kill()
+		if !c.Exited() {
+			t.Errorf("got running, but want exited")
+		}
+		_, err = c.ListRules(&proto.ListRulesRequest{})
+		if err == nil {
+			t.Errorf("got err nil, but want err")
+		}
+	})
+}
+
+// waitExited waits for the module to exit, since it exits asynchronously.
+func waitExited(c *wasm.Client) bool {
+	for i := 0; i < 100; i++ {
+		if c.Exited() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
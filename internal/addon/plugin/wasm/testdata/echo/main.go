@@ -0,0 +1,46 @@
+// Command echo is the minimal WASI module for the tests of the wasm client.
+// Its rule reports the content it receives, and it exits on the EXIT rule.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/proto"
+	"github.com/yoheimuta/protolint/internal/addon/plugin/wasi"
+)
+
+type echoRuleSet struct{}
+
+func (echoRuleSet) ListRules(*proto.ListRulesRequest) (*proto.ListRulesResponse, error) {
+	return &proto.ListRulesResponse{
+		Rules: []*proto.ListRulesResponse_Rule{
+			{
+				Id:      "ECHO",
+				Purpose: "Reports the content.",
+			},
+		},
+	}, nil
+}
+
+func (echoRuleSet) Apply(req *proto.ApplyRequest) (*proto.ApplyResponse, error) {
+	if req.Id == "EXIT" {
+		os.Exit(0)
+	}
+	return &proto.ApplyResponse{
+		Failures: []*proto.ApplyResponse_Failure{
+			{
+				Message: string(req.Content),
+				Pos:     &proto.ApplyResponse_Position{Line: 1, Column: 1},
+			},
+		},
+	}, nil
+}
+
+func main() {
+	err := wasi.Serve(os.Stdin, os.Stdout, echoRuleSet{})
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -54,6 +54,26 @@ func (r EnumFieldNamesPrefixRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r EnumFieldNamesPrefixRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r EnumFieldNamesPrefixRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Each enum value name must be prefixed with the enum name in UPPER_SNAKE_CASE, which avoids name collisions among enums in the same package.",
+		GoodExample: `enum FooBar {
+  FOO_BAR_UNSPECIFIED = 0;
+}`,
+		BadExample: `enum FooBar {
+  UNSPECIFIED = 0;
+}`,
+		Fixable:         true,
+		AutoDisableable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumFieldNamesPrefixRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
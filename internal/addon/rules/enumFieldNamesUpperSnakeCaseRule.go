@@ -50,6 +50,26 @@ func (r EnumFieldNamesUpperSnakeCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r EnumFieldNamesUpperSnakeCaseRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r EnumFieldNamesUpperSnakeCaseRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Enum value names must be written in UPPER_SNAKE_CASE as the official style guide recommends.",
+		GoodExample: `enum Foo {
+  FIRST_VALUE = 0;
+}`,
+		BadExample: `enum Foo {
+  firstValue = 0;
+}`,
+		Fixable:         true,
+		AutoDisableable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumFieldNamesUpperSnakeCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
@@ -63,6 +63,33 @@ func (r EnumFieldNamesZeroValueEndWithRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r EnumFieldNamesZeroValueEndWithRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r EnumFieldNamesZeroValueEndWithRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "The enum value with the number zero must end with the suffix, so that the default value is explicitly unspecified.",
+		GoodExample: `enum Foo {
+  FOO_UNSPECIFIED = 0;
+}`,
+		BadExample: `enum Foo {
+  FOO_FIRST = 0;
+}`,
+		Options: []rule.Option{
+			{
+				Name:        "suffix",
+				Default:     "UNSPECIFIED",
+				Description: "The suffix the zero value must end with.",
+			},
+		},
+		Fixable:         true,
+		AutoDisableable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumFieldNamesZeroValueEndWithRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
@@ -42,6 +42,32 @@ func (r EnumFieldsHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r EnumFieldsHaveCommentRule) Categories() []string {
+	return []string{rule.CategoryDocumentation}
+}
+
+// Metadata returns the details of this rule.
+func (r EnumFieldsHaveCommentRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Each enum value must have a leading or trailing comment that documents it.",
+		GoodExample: `enum Foo {
+  // FOO_UNSPECIFIED is the default value.
+  FOO_UNSPECIFIED = 0;
+}`,
+		BadExample: `enum Foo {
+  FOO_UNSPECIFIED = 0;
+}`,
+		Options: []rule.Option{
+			{
+				Name:        "should_follow_golang_style",
+				Default:     "false",
+				Description: "Requires the comment to start with the name of the element, following the Go style.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumFieldsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &enumFieldsHaveCommentVisitor{
@@ -51,6 +51,26 @@ func (r EnumNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r EnumNamesUpperCamelCaseRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r EnumNamesUpperCamelCaseRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Enum names must be written in UpperCamelCase as the official style guide recommends.",
+		GoodExample: `enum FooBar {
+  FOO_BAR_UNSPECIFIED = 0;
+}`,
+		BadExample: `enum foo_bar {
+  FOO_BAR_UNSPECIFIED = 0;
+}`,
+		Fixable:         true,
+		AutoDisableable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
@@ -42,6 +42,32 @@ func (r EnumsHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r EnumsHaveCommentRule) Categories() []string {
+	return []string{rule.CategoryDocumentation}
+}
+
+// Metadata returns the details of this rule.
+func (r EnumsHaveCommentRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Each enum must have a leading or trailing comment that documents it.",
+		GoodExample: `// Foo is an example.
+enum Foo {
+  FOO_UNSPECIFIED = 0;
+}`,
+		BadExample: `enum Foo {
+  FOO_UNSPECIFIED = 0;
+}`,
+		Options: []rule.Option{
+			{
+				Name:        "should_follow_golang_style",
+				Default:     "false",
+				Description: "Requires the comment to start with the name of the element, following the Go style.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r EnumsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &enumsHaveCommentVisitor{
@@ -79,6 +79,36 @@ func (r FieldNamesExcludePrepositionsRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r FieldNamesExcludePrepositionsRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r FieldNamesExcludePrepositionsRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Field names must not include prepositions, which usually means the field should be split or renamed as the Google API design guide recommends.",
+		GoodExample: `message Book {
+  string error_reason = 1;
+}`,
+		BadExample: `message Book {
+  string reason_for_error = 1;
+}`,
+		Options: []rule.Option{
+			{
+				Name:        "prepositions",
+				Default:     "[of, with, at, ...]",
+				Description: "The prepositions to check for. They replace the default list.",
+			},
+			{
+				Name:        "excludes",
+				Default:     "[]",
+				Description: "The words to allow even if they include a preposition.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r FieldNamesExcludePrepositionsRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fieldNamesExcludePrepositionsVisitor{
@@ -52,6 +52,26 @@ func (r FieldNamesLowerSnakeCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r FieldNamesLowerSnakeCaseRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r FieldNamesLowerSnakeCaseRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Field names must be written in lower_snake_case as the official style guide recommends.",
+		GoodExample: `message Book {
+  string song_name = 1;
+}`,
+		BadExample: `message Book {
+  string songName = 1;
+}`,
+		Fixable:         true,
+		AutoDisableable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r FieldNamesLowerSnakeCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
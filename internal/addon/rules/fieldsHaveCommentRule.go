@@ -42,6 +42,32 @@ func (r FieldsHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r FieldsHaveCommentRule) Categories() []string {
+	return []string{rule.CategoryDocumentation}
+}
+
+// Metadata returns the details of this rule.
+func (r FieldsHaveCommentRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Each field must have a leading or trailing comment that documents it.",
+		GoodExample: `message Book {
+  // name is the title of the book.
+  string name = 1;
+}`,
+		BadExample: `message Book {
+  string name = 1;
+}`,
+		Options: []rule.Option{
+			{
+				Name:        "should_follow_golang_style",
+				Default:     "false",
+				Description: "Requires the comment to start with the name of the element, following the Go style.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r FieldsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fieldsHaveCommentVisitor{
@@ -34,6 +34,21 @@ func (r FileHasCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r FileHasCommentRule) Categories() []string {
+	return []string{rule.CategoryDocumentation}
+}
+
+// Metadata returns the details of this rule.
+func (r FileHasCommentRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "The file must start with a comment before the syntax statement, which documents the file.",
+		GoodExample: `// This file defines the book API.
+syntax = "proto3";`,
+		BadExample: `syntax = "proto3";`,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r FileHasCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fileHasCommentVisitor{
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/yoheimuta/protolint/internal/linter/file"
 	"github.com/yoheimuta/protolint/internal/stringsutil"
 
 	"github.com/yoheimuta/go-protoparser/v4/parser"
@@ -51,6 +52,30 @@ func (r FileNamesLowerSnakeCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r FileNamesLowerSnakeCaseRule) Categories() []string {
+	return []string{rule.CategoryNaming, rule.CategoryLayout}
+}
+
+// Metadata returns the details of this rule.
+func (r FileNamesLowerSnakeCaseRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "File names must be written in lower_snake_case and end with .proto. With -fix, the file is renamed.",
+		GoodExample: `// book_service.proto
+syntax = "proto3";`,
+		BadExample: `// BookService.proto
+syntax = "proto3";`,
+		Options: []rule.Option{
+			{
+				Name:        "excludes",
+				Default:     "[]",
+				Description: "The file paths to skip.",
+			},
+		},
+		Fixable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r FileNamesLowerSnakeCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fileNamesLowerSnakeCaseVisitor{
@@ -82,20 +107,20 @@ func (v *fileNamesLowerSnakeCaseVisitor) OnStart(proto *parser.Proto) error {
 		expected += ".proto"
 		v.AddFailurefWithProtoMeta(proto.Meta, "File name %q should be lower_snake_case.proto like %q.", filename, expected)
 
-		if v.fixMode {
-			dir := filepath.Dir(path)
-			newPath := filepath.Join(dir, expected)
+		// An in-memory file, like the one read from stdin, can't be renamed.
+		if f := file.FromProto(proto); v.fixMode && !f.IsInMemory() {
+			newPath := filepath.Join(filepath.Dir(f.Path()), expected)
 			if _, err := os.Stat(newPath); !os.IsNotExist(err) {
 				v.AddFailurefWithProtoMeta(proto.Meta, "Failed to rename %q because %q already exists.", filename, expected)
 				return nil
 			}
-			err := os.Rename(path, newPath)
+			err := os.Rename(f.Path(), newPath)
 			if err != nil {
 				return err
 			}
 
 			// Notify the upstream this new filename by updating the proto.
-			proto.Meta.Filename = newPath
+			proto.Meta.Filename = filepath.Join(filepath.Dir(path), expected)
 		}
 	}
 	return nil
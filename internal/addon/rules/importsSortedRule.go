@@ -1,9 +1,11 @@
 package rules
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
 
 	"github.com/yoheimuta/protolint/linter/report"
 	"github.com/yoheimuta/protolint/linter/rule"
@@ -42,6 +44,30 @@ func (r ImportsSortedRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r ImportsSortedRule) Categories() []string {
+	return []string{rule.CategoryStyle, rule.CategoryLayout}
+}
+
+// Metadata returns the details of this rule.
+func (r ImportsSortedRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Each group of consecutive import statements must be sorted in the alphabetical order.",
+		GoodExample: `import "a.proto";
+import "b.proto";`,
+		BadExample: `import "b.proto";
+import "a.proto";`,
+		Options: []rule.Option{
+			{
+				Name:        "newline",
+				Default:     "\\n",
+				Description: "Deprecated: not used.",
+			},
+		},
+		Fixable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r ImportsSortedRule) Apply(
 	proto *parser.Proto,
@@ -78,10 +104,13 @@ func (v importsSortedVisitor) Finally() error {
 
 		for i, line := range lines {
 			if invalid, ok := notSorted[i+1]; ok {
-				v.AddFailuref(
+				v.AddFailure(v.Failuref(
 					invalid.Meta.Pos,
 					`Imports are not sorted.`,
-				)
+				).WithEnd(nextPosition(invalid.Meta.LastPos)).WithSecondaryLocations(report.Location{
+					Pos:     invalid.sortedPos,
+					Message: fmt.Sprintf("This import belongs at line %d.", invalid.Meta.Pos.Line),
+				}))
 				line = lines[invalid.sortedLine-1]
 			}
 			fixedLines = append(fixedLines, line)
@@ -94,9 +123,18 @@ func (v importsSortedVisitor) Finally() error {
 	return v.BaseFixableVisitor.Finally()
 }
 
+// nextPosition returns the position just after the character at pos, which is on the same line.
+func nextPosition(pos meta.Position) meta.Position {
+	pos.Offset++
+	pos.Column++
+	return pos
+}
+
 type notSortedImport struct {
 	*parser.Import
 	sortedLine int
+	// sortedPos is the position of the import which belongs at the line of this one.
+	sortedPos meta.Position
 }
 
 type importGroup []*parser.Import
@@ -123,6 +161,7 @@ func (g importGroup) notSortedImports() map[int]*notSortedImport {
 			is[i.Meta.Pos.Line] = &notSortedImport{
 				Import:     i,
 				sortedLine: sorted.Meta.Pos.Line,
+				sortedPos:  sorted.Meta.Pos,
 			}
 		}
 	}
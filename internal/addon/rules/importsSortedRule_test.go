@@ -48,7 +48,20 @@ func TestImportsSortedRule_Apply(t *testing.T) {
 					},
 					"IMPORTS_SORTED",
 					`Imports are not sorted.`,
-				),
+				).WithEnd(meta.Position{
+					Filename: testImportSortedProtoPath("notSorted.proto"),
+					Offset:   46,
+					Line:     3,
+					Column:   27,
+				}).WithSecondaryLocations(report.Location{
+					Pos: meta.Position{
+						Filename: testImportSortedProtoPath("notSorted.proto"),
+						Offset:   47,
+						Line:     4,
+						Column:   1,
+					},
+					Message: "This import belongs at line 3.",
+				}),
 				report.Failuref(
 					meta.Position{
 						Filename: testImportSortedProtoPath("notSorted.proto"),
@@ -58,7 +71,20 @@ func TestImportsSortedRule_Apply(t *testing.T) {
 					},
 					"IMPORTS_SORTED",
 					`Imports are not sorted.`,
-				),
+				).WithEnd(meta.Position{
+					Filename: testImportSortedProtoPath("notSorted.proto"),
+					Offset:   85,
+					Line:     4,
+					Column:   39,
+				}).WithSecondaryLocations(report.Location{
+					Pos: meta.Position{
+						Filename: testImportSortedProtoPath("notSorted.proto"),
+						Offset:   20,
+						Line:     3,
+						Column:   1,
+					},
+					Message: "This import belongs at line 4.",
+				}),
 			},
 		},
 		{
@@ -74,7 +100,20 @@ func TestImportsSortedRule_Apply(t *testing.T) {
 					},
 					"IMPORTS_SORTED",
 					`Imports are not sorted.`,
-				),
+				).WithEnd(meta.Position{
+					Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+					Offset:   41,
+					Line:     3,
+					Column:   22,
+				}).WithSecondaryLocations(report.Location{
+					Pos: meta.Position{
+						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+						Offset:   42,
+						Line:     4,
+						Column:   1,
+					},
+					Message: "This import belongs at line 3.",
+				}),
 				report.Failuref(
 					meta.Position{
 						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
@@ -84,7 +123,20 @@ func TestImportsSortedRule_Apply(t *testing.T) {
 					},
 					"IMPORTS_SORTED",
 					`Imports are not sorted.`,
-				),
+				).WithEnd(meta.Position{
+					Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+					Offset:   68,
+					Line:     4,
+					Column:   27,
+				}).WithSecondaryLocations(report.Location{
+					Pos: meta.Position{
+						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+						Offset:   20,
+						Line:     3,
+						Column:   1,
+					},
+					Message: "This import belongs at line 4.",
+				}),
 				report.Failuref(
 					meta.Position{
 						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
@@ -94,7 +146,20 @@ func TestImportsSortedRule_Apply(t *testing.T) {
 					},
 					"IMPORTS_SORTED",
 					`Imports are not sorted.`,
-				),
+				).WithEnd(meta.Position{
+					Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+					Offset:   189,
+					Line:     9,
+					Column:   39,
+				}).WithSecondaryLocations(report.Location{
+					Pos: meta.Position{
+						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+						Offset:   190,
+						Line:     10,
+						Column:   1,
+					},
+					Message: "This import belongs at line 9.",
+				}),
 				report.Failuref(
 					meta.Position{
 						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
@@ -104,7 +169,20 @@ func TestImportsSortedRule_Apply(t *testing.T) {
 					},
 					"IMPORTS_SORTED",
 					`Imports are not sorted.`,
-				),
+				).WithEnd(meta.Position{
+					Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+					Offset:   227,
+					Line:     10,
+					Column:   38,
+				}).WithSecondaryLocations(report.Location{
+					Pos: meta.Position{
+						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+						Offset:   151,
+						Line:     9,
+						Column:   1,
+					},
+					Message: "This import belongs at line 10.",
+				}),
 			},
 		},
 	}
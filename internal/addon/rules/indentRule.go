@@ -60,6 +60,42 @@ func (r IndentRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r IndentRule) Categories() []string {
+	return []string{rule.CategoryStyle}
+}
+
+// Metadata returns the details of this rule.
+func (r IndentRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Each statement must be indented by the style according to its nesting depth.",
+		GoodExample: `message Book {
+  string name = 1;
+}`,
+		BadExample: `message Book {
+string name = 1;
+}`,
+		Options: []rule.Option{
+			{
+				Name:        "style",
+				Default:     "2",
+				Description: "The indentation style. Valid values are tab, 4 or 2.",
+			},
+			{
+				Name:        "newline",
+				Default:     "\\n",
+				Description: "Deprecated: not used.",
+			},
+			{
+				Name:        "not_insert_newline",
+				Default:     "false",
+				Description: "Doesn't insert a newline to fix a statement on the same line as another one.",
+			},
+		},
+		Fixable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r IndentRule) Apply(
 	proto *parser.Proto,
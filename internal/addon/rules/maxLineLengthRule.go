@@ -2,13 +2,14 @@ package rules
 
 import (
 	"bufio"
-	"os"
+	"bytes"
 	"strings"
 	"unicode/utf8"
 
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
 
+	"github.com/yoheimuta/protolint/internal/linter/file"
 	"github.com/yoheimuta/protolint/linter/disablerule"
 	"github.com/yoheimuta/protolint/linter/report"
 	"github.com/yoheimuta/protolint/linter/rule"
@@ -64,30 +65,56 @@ func (r MaxLineLengthRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r MaxLineLengthRule) Categories() []string {
+	return []string{rule.CategoryStyle}
+}
+
+// Metadata returns the details of this rule.
+func (r MaxLineLengthRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Each line must not exceed the maximum number of characters, counting a tab as the given number of characters.",
+		GoodExample: `// This comment is short enough.`,
+		BadExample:  `// This comment is too long because it keeps going on and on beyond eighty characters.`,
+		Options: []rule.Option{
+			{
+				Name:        "max_chars",
+				Default:     "80",
+				Description: "The maximum number of characters per line.",
+			},
+			{
+				Name:        "tab_chars",
+				Default:     "4",
+				Description: "The number of characters a tab counts as.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r MaxLineLengthRule) Apply(proto *parser.Proto) (
 	failures []report.Failure,
 	err error,
 ) {
 	fileName := proto.Meta.Filename
-	reader, err := os.Open(fileName)
+	content, err := file.FromProto(proto).Content()
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		closeErr := reader.Close()
-		if err != nil {
-			return
-		}
-		if closeErr != nil {
-			err = closeErr
-		}
-	}()
 
 	var lines []string
-	scanner := bufio.NewScanner(reader)
+	// lineOffsets holds the offsets of the beginnings of the lines.
+	var lineOffsets []int
+	offset := 0
+	scanner := bufio.NewScanner(bytes.NewReader(content))
 	for scanner.Scan() {
 		lines = append(lines, scanner.Text())
+		lineOffsets = append(lineOffsets, offset)
+		if i := bytes.IndexByte(content[offset:], '\n'); 0 <= i {
+			offset += i + 1
+		} else {
+			offset = len(content)
+		}
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -97,12 +124,19 @@ func (r MaxLineLengthRule) Apply(proto *parser.Proto) (
 	disablerule.NewInterpreter(r.ID()).CallEachIfValid(
 		lines,
 		func(index int, line string) {
+			end := meta.Position{
+				Filename: fileName,
+				Offset:   lineOffsets[index] + len(line),
+				Line:     index + 1,
+				Column:   utf8.RuneCountInString(line) + 1,
+			}
 			line = strings.Replace(line, "\t", strings.Repeat(" ", r.tabChars), -1)
 			lineCount := utf8.RuneCountInString(line)
 			if r.maxChars < lineCount {
 				failures = append(failures, report.Failuref(
 					meta.Position{
 						Filename: fileName,
+						Offset:   lineOffsets[index],
 						Line:     index + 1,
 						Column:   1,
 					},
@@ -110,7 +144,7 @@ func (r MaxLineLengthRule) Apply(proto *parser.Proto) (
 					"The line length is %d, but it must be shorter than %d",
 					lineCount,
 					r.maxChars,
-				))
+				).WithEnd(end))
 			}
 		},
 	)
@@ -55,21 +55,33 @@ func TestMaxLineLengthRule_Apply(t *testing.T) {
 				report.Failuref(
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+						Offset:   65,
 						Line:     3,
 						Column:   1,
 					},
 					"MAX_LINE_LENGTH",
 					`The line length is 91, but it must be shorter than 80`,
-				),
+				).WithEnd(meta.Position{
+					Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+					Offset:   156,
+					Line:     3,
+					Column:   92,
+				}),
 				report.Failuref(
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+						Offset:   655,
 						Line:     15,
 						Column:   1,
 					},
 					"MAX_LINE_LENGTH",
 					`The line length is 88, but it must be shorter than 80`,
-				),
+				).WithEnd(meta.Position{
+					Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+					Offset:   743,
+					Line:     15,
+					Column:   89,
+				}),
 			},
 		},
 	}
@@ -49,6 +49,32 @@ func (r MessageNamesExcludePrepositionsRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r MessageNamesExcludePrepositionsRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r MessageNamesExcludePrepositionsRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Message names must not include prepositions, which usually means the message should be renamed.",
+		GoodExample: `message SaleBook {}`,
+		BadExample:  `message BookForSale {}`,
+		Options: []rule.Option{
+			{
+				Name:        "prepositions",
+				Default:     "[Of, With, At, ...]",
+				Description: "The prepositions to check for. They replace the default list.",
+			},
+			{
+				Name:        "excludes",
+				Default:     "[]",
+				Description: "The words to allow even if they include a preposition.",
+			},
+		},
+	}
+}
+
 // Purpose returns the purpose of this rule.
 func (r MessageNamesExcludePrepositionsRule) Purpose() string {
 	return `Verifies that all message names don't include prepositions (e.g. "With", "For").`
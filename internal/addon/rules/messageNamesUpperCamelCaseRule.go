@@ -51,6 +51,22 @@ func (r MessageNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r MessageNamesUpperCamelCaseRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r MessageNamesUpperCamelCaseRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description:     "Message names must be written in UpperCamelCase as the official style guide recommends.",
+		GoodExample:     `message SongServerRequest {}`,
+		BadExample:      `message song_server_request {}`,
+		Fixable:         true,
+		AutoDisableable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r MessageNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
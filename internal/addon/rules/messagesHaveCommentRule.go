@@ -45,6 +45,28 @@ func (r MessagesHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r MessagesHaveCommentRule) Categories() []string {
+	return []string{rule.CategoryDocumentation}
+}
+
+// Metadata returns the details of this rule.
+func (r MessagesHaveCommentRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Each message must have a leading or trailing comment that documents it.",
+		GoodExample: `// Book represents a book.
+message Book {}`,
+		BadExample: `message Book {}`,
+		Options: []rule.Option{
+			{
+				Name:        "should_follow_golang_style",
+				Default:     "false",
+				Description: "Requires the comment to start with the name of the element, following the Go style.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r MessagesHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &messagesHaveCommentVisitor{
@@ -48,6 +48,23 @@ func (r OrderRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r OrderRule) Categories() []string {
+	return []string{rule.CategoryLayout}
+}
+
+// Metadata returns the details of this rule.
+func (r OrderRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "The top-level statements must be ordered as syntax, package, imports, file options and everything else, as the official style guide recommends.",
+		GoodExample: `syntax = "proto3";
+package foo;`,
+		BadExample: `package foo;
+syntax = "proto3";`,
+		Fixable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r OrderRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
@@ -46,6 +46,21 @@ func (r PackageNameLowerCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r PackageNameLowerCaseRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r PackageNameLowerCaseRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "The package name must consist of lowercase letters, digits and dots only.",
+		GoodExample: `package my.package;`,
+		BadExample:  `package myPackage;`,
+		Fixable:     true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r PackageNameLowerCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
@@ -42,6 +42,27 @@ func (r Proto3FieldsAvoidRequiredRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r Proto3FieldsAvoidRequiredRule) Categories() []string {
+	return []string{rule.CategoryCompatibility}
+}
+
+// Metadata returns the details of this rule.
+func (r Proto3FieldsAvoidRequiredRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Fields in proto3 files must not use the required label, which proto3 doesn't support.",
+		GoodExample: `syntax = "proto3";
+message Book {
+  string name = 1;
+}`,
+		BadExample: `syntax = "proto3";
+message Book {
+  required string name = 1;
+}`,
+		Fixable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r Proto3FieldsAvoidRequiredRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
@@ -41,6 +41,32 @@ func (r Proto3GroupsAvoidRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r Proto3GroupsAvoidRule) Categories() []string {
+	return []string{rule.CategoryCompatibility}
+}
+
+// Metadata returns the details of this rule.
+func (r Proto3GroupsAvoidRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Proto3 files must not use groups, which are deprecated. Use a nested message instead.",
+		GoodExample: `syntax = "proto3";
+message Book {
+  message Result {
+    string url = 1;
+  }
+  repeated Result result = 1;
+}`,
+		BadExample: `syntax = "proto3";
+message Book {
+  repeated group Result = 1 {
+    string url = 2;
+  }
+}`,
+		AutoDisableable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r Proto3GroupsAvoidRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &proto3GroupsAvoidVisitor{
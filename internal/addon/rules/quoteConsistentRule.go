@@ -46,6 +46,28 @@ func (r QuoteConsistentRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r QuoteConsistentRule) Categories() []string {
+	return []string{rule.CategoryStyle}
+}
+
+// Metadata returns the details of this rule.
+func (r QuoteConsistentRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "All string literals must use the same kind of quotation marks.",
+		GoodExample: `import "a.proto";`,
+		BadExample:  `import 'a.proto';`,
+		Options: []rule.Option{
+			{
+				Name:        "quote",
+				Default:     "double",
+				Description: "The quotation mark to use. Valid values are double or single.",
+			},
+		},
+		Fixable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r QuoteConsistentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
@@ -65,6 +65,48 @@ func (r RepeatedFieldNamesPluralizedRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r RepeatedFieldNamesPluralizedRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r RepeatedFieldNamesPluralizedRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Names of repeated fields must be in the plural form as the official style guide recommends.",
+		GoodExample: `message Book {
+  repeated string authors = 1;
+}`,
+		BadExample: `message Book {
+  repeated string author = 1;
+}`,
+		Options: []rule.Option{
+			{
+				Name:        "plural_rules",
+				Default:     "{}",
+				Description: "Additional rules from a singular form to its plural form.",
+			},
+			{
+				Name:        "singular_rules",
+				Default:     "{}",
+				Description: "Additional rules from a plural form to its singular form.",
+			},
+			{
+				Name:        "uncountable_rules",
+				Default:     "[]",
+				Description: "Additional words which have no plural form.",
+			},
+			{
+				Name:        "irregular_rules",
+				Default:     "{}",
+				Description: "Additional irregular words from the singular form to the plural form.",
+			},
+		},
+		Fixable:         true,
+		AutoDisableable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r RepeatedFieldNamesPluralizedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	c := strs.NewPluralizeClient()
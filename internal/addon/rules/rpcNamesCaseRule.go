@@ -42,6 +42,31 @@ func (r RPCNamesCaseRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r RPCNamesCaseRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r RPCNamesCaseRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "RPC names must be written in the naming convention.",
+		GoodExample: `service Foo {
+  rpc getSomething(Request) returns (Response);
+}`,
+		BadExample: `service Foo {
+  rpc get_something(Request) returns (Response);
+}`,
+		Options: []rule.Option{
+			{
+				Name:        "convention",
+				Default:     "",
+				Description: "The naming convention. Valid values are lower_camel_case, upper_snake_case or lower_snake_case.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r RPCNamesCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &rpcNamesCaseVisitor{
@@ -51,6 +51,26 @@ func (r RPCNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r RPCNamesUpperCamelCaseRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r RPCNamesUpperCamelCaseRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "RPC names must be written in UpperCamelCase as the official style guide recommends.",
+		GoodExample: `service Foo {
+  rpc GetSomething(Request) returns (Response);
+}`,
+		BadExample: `service Foo {
+  rpc get_something(Request) returns (Response);
+}`,
+		Fixable:         true,
+		AutoDisableable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r RPCNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
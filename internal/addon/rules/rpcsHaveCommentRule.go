@@ -42,6 +42,32 @@ func (r RPCsHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r RPCsHaveCommentRule) Categories() []string {
+	return []string{rule.CategoryDocumentation}
+}
+
+// Metadata returns the details of this rule.
+func (r RPCsHaveCommentRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Each RPC must have a leading or trailing comment that documents it.",
+		GoodExample: `service Foo {
+  // GetBook returns the book.
+  rpc GetBook(Request) returns (Response);
+}`,
+		BadExample: `service Foo {
+  rpc GetBook(Request) returns (Response);
+}`,
+		Options: []rule.Option{
+			{
+				Name:        "should_follow_golang_style",
+				Default:     "false",
+				Description: "Requires the comment to start with the name of the element, following the Go style.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r RPCsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &rpcsHaveCommentVisitor{
@@ -42,6 +42,27 @@ func (r ServiceNamesEndWithRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r ServiceNamesEndWithRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r ServiceNamesEndWithRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Service names must end with the text, for example Service.",
+		GoodExample: `service BookService {}`,
+		BadExample:  `service Book {}`,
+		Options: []rule.Option{
+			{
+				Name:        "text",
+				Default:     "",
+				Description: "The text service names must end with.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r ServiceNamesEndWithRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &serviceNamesEndWithVisitor{
@@ -51,6 +51,22 @@ func (r ServiceNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// Categories returns the categories of this rule.
+func (r ServiceNamesUpperCamelCaseRule) Categories() []string {
+	return []string{rule.CategoryNaming}
+}
+
+// Metadata returns the details of this rule.
+func (r ServiceNamesUpperCamelCaseRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description:     "Service names must be written in UpperCamelCase as the official style guide recommends.",
+		GoodExample:     `service BookService {}`,
+		BadExample:      `service book_service {}`,
+		Fixable:         true,
+		AutoDisableable: true,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r ServiceNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
@@ -42,6 +42,28 @@ func (r ServicesHaveCommentRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r ServicesHaveCommentRule) Categories() []string {
+	return []string{rule.CategoryDocumentation}
+}
+
+// Metadata returns the details of this rule.
+func (r ServicesHaveCommentRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "Each service must have a leading or trailing comment that documents it.",
+		GoodExample: `// BookService manages books.
+service BookService {}`,
+		BadExample: `service BookService {}`,
+		Options: []rule.Option{
+			{
+				Name:        "should_follow_golang_style",
+				Default:     "false",
+				Description: "Requires the comment to start with the name of the element, following the Go style.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r ServicesHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &servicesHaveCommentVisitor{
@@ -43,6 +43,27 @@ func (r SyntaxConsistentRule) IsOfficial() bool {
 	return false
 }
 
+// Categories returns the categories of this rule.
+func (r SyntaxConsistentRule) Categories() []string {
+	return []string{rule.CategoryCompatibility}
+}
+
+// Metadata returns the details of this rule.
+func (r SyntaxConsistentRule) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "The syntax statement must specify the version, so that all files use the same syntax.",
+		GoodExample: `syntax = "proto3";`,
+		BadExample:  `syntax = "proto2";`,
+		Options: []rule.Option{
+			{
+				Name:        "version",
+				Default:     "proto3",
+				Description: "The syntax version to use.",
+			},
+		},
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r SyntaxConsistentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &syntaxConsistentVisitor{
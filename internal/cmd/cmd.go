@@ -5,8 +5,11 @@ import (
 	"io"
 	"strings"
 
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/explain"
 	"github.com/yoheimuta/protolint/internal/cmd/subcmds/lint"
 	"github.com/yoheimuta/protolint/internal/cmd/subcmds/list"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/rename"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/serve"
 	"github.com/yoheimuta/protolint/internal/osutil"
 )
 
@@ -20,6 +23,9 @@ Usage:
 The commands are:
 	lint     lint protocol buffer files
 	list     list all current lint rules being used
+	explain  explain a lint rule in detail
+	rename   rename a message or an enum together with the references to it
+	serve    serve the lint API over gRPC and HTTP
 	version  print protolint version
 `
 )
@@ -27,6 +33,9 @@ The commands are:
 const (
 	subCmdLint    = "lint"
 	subCmdList    = "list"
+	subCmdExplain = "explain"
+	subCmdRename  = "rename"
+	subCmdServe   = "serve"
 	subCmdVersion = "version"
 )
 
@@ -64,6 +73,12 @@ func doSub(
 		return doLint(args[1:], stdout, stderr)
 	case subCmdList:
 		return doList(args[1:], stdout, stderr)
+	case subCmdExplain:
+		return doExplain(args[1:], stdout, stderr)
+	case subCmdRename:
+		return doRename(args[1:], stdout, stderr)
+	case subCmdServe:
+		return doServe(args[1:], stdout, stderr)
 	case subCmdVersion:
 		return doVersion(stdout)
 	default:
@@ -87,7 +102,8 @@ func doLint(
 		_, _ = fmt.Fprint(stderr, err)
 		return osutil.ExitInternalFailure
 	}
-	if len(flags.Args()) < 1 {
+	flags.Version = version + "(" + revision + ")"
+	if len(flags.Args()) < 1 && !flags.Stdin {
 		_, _ = fmt.Fprintln(stderr, "protolint lint requires at least one argument. See Usage.")
 		_, _ = fmt.Fprint(stderr, help)
 		return osutil.ExitInternalFailure
@@ -128,6 +144,60 @@ func doList(
 	return subCmd.Run()
 }
 
+func doExplain(
+	args []string,
+	stdout io.Writer,
+	stderr io.Writer,
+) osutil.ExitCode {
+	flags, err := explain.NewFlags(args)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	subCmd := explain.NewCmdExplain(
+		flags,
+		stdout,
+		stderr,
+	)
+	return subCmd.Run()
+}
+
+func doRename(
+	args []string,
+	stdout io.Writer,
+	stderr io.Writer,
+) osutil.ExitCode {
+	flags, err := rename.NewFlags(args)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	subCmd := rename.NewCmdRename(
+		flags,
+		stdout,
+		stderr,
+	)
+	return subCmd.Run()
+}
+
+func doServe(
+	args []string,
+	stdout io.Writer,
+	stderr io.Writer,
+) osutil.ExitCode {
+	flags, err := serve.NewFlags(args)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	subCmd := serve.NewCmdServe(
+		flags,
+		stdout,
+		stderr,
+	)
+	return subCmd.Run()
+}
+
 func doVersion(
 	stdout io.Writer,
 ) osutil.ExitCode {
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/types/pluginpb"
 
@@ -93,6 +94,7 @@ func newSubCmd(
 	if err != nil {
 		return nil, err
 	}
+	flags.Version = version + "(" + revision + ")"
 
 	subCmd, err := lint.NewCmdLint(
 		*flags,
@@ -154,6 +156,17 @@ func newFlags(
 			if err != nil {
 				return nil, err
 			}
+		case "plugin_timeout":
+			if len(params) != 2 {
+				return nil, fmt.Errorf("plugin_timeout should be specified")
+			}
+			timeout, err := time.ParseDuration(params[1])
+			if err != nil {
+				return nil, err
+			}
+			flags.PluginTimeout = timeout
+		case "plugin_error_as_failure":
+			flags.PluginErrorAsFailure = true
 		case "v":
 			flags.Verbose = true
 		case "proto_root":
@@ -168,7 +181,7 @@ func newFlags(
 		}
 	}
 
-	plugins, err := pf.BuildPlugins(flags.Verbose)
+	plugins, err := pf.BuildPlugins(flags.Verbose, flags.PluginTimeout)
 	if err != nil {
 		return nil, err
 	}
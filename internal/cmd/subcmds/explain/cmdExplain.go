@@ -0,0 +1,77 @@
+package explain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/wasm"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/list"
+	"github.com/yoheimuta/protolint/internal/linter/config"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/internal/osutil"
+	"github.com/yoheimuta/protolint/linter/autodisable"
+)
+
+// CmdExplain is a command to explain a rule in detail.
+type CmdExplain struct {
+	stdout io.Writer
+	stderr io.Writer
+	flags  Flags
+}
+
+// NewCmdExplain creates a new CmdExplain.
+func NewCmdExplain(
+	flags Flags,
+	stdout io.Writer,
+	stderr io.Writer,
+) *CmdExplain {
+	return &CmdExplain{
+		flags:  flags,
+		stdout: stdout,
+		stderr: stderr,
+	}
+}
+
+// Run explains the rule.
+func (c *CmdExplain) Run() osutil.ExitCode {
+	defer plugin.CleanupClients()
+	defer wasm.CleanupClients()
+
+	err := c.run()
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	return osutil.ExitSuccess
+}
+
+func (c *CmdExplain) run() error {
+	rules, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, false, c.flags.Plugins, false)
+	if err != nil {
+		return err
+	}
+	r, ok := rules.Find(c.flags.RuleID)
+	if !ok {
+		return fmt.Errorf("not found rule %s. Run `protolint list` to see all rules", c.flags.RuleID)
+	}
+	info := internalrule.NewInfo(r)
+
+	switch c.flags.Format {
+	case list.FormatJSON:
+		bs, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(c.stdout, "%s\n", bs)
+		return err
+	case list.FormatMarkdown:
+		_, err = fmt.Fprint(c.stdout, info.Markdown())
+		return err
+	}
+	_, err = fmt.Fprint(c.stdout, info.Text())
+	return err
+}
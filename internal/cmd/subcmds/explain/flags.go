@@ -0,0 +1,60 @@
+package explain
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/shared"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/list"
+)
+
+// Flags represents a set of explain flag parameters.
+type Flags struct {
+	*flag.FlagSet
+
+	Plugins []shared.RuleSet
+	Format  string
+	RuleID  string
+}
+
+// NewFlags creates a new Flags.
+func NewFlags(
+	args []string,
+) (Flags, error) {
+	f := Flags{
+		FlagSet: flag.NewFlagSet("explain", flag.ExitOnError),
+	}
+	var pf subcmds.PluginFlag
+
+	f.Var(
+		&pf,
+		"plugin",
+		`plugins to provide custom lint rule set. Note that it's necessary to specify it as path format'. A path ending with .wasm is loaded as a sandboxed WASI module`,
+	)
+	f.StringVar(
+		&f.Format,
+		"format",
+		list.FormatPlain,
+		"output format. Valid values are plain, json or markdown",
+	)
+
+	_ = f.Parse(args)
+
+	switch f.Format {
+	case list.FormatPlain, list.FormatJSON, list.FormatMarkdown:
+	default:
+		return Flags{}, fmt.Errorf("%s is an invalid format. valid format is plain, json or markdown", f.Format)
+	}
+	if f.NArg() != 1 {
+		return Flags{}, fmt.Errorf("protolint explain requires exactly one rule ID, but got %v", f.Args())
+	}
+	f.RuleID = f.Arg(0)
+
+	plugins, err := pf.BuildPlugins(false, subcmds.DefaultPluginTimeout)
+	if err != nil {
+		return Flags{}, err
+	}
+	f.Plugins = plugins
+	return f, nil
+}
@@ -0,0 +1,161 @@
+package lint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin"
+	"github.com/yoheimuta/protolint/internal/linter/cache"
+	"github.com/yoheimuta/protolint/internal/linter/config"
+	"github.com/yoheimuta/protolint/internal/linter/file"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/autodisable"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// newCacheSalt creates the part of the cache key common to all files in a run.
+// It changes whenever the protolint binary, the resolved config, or any plugin changes.
+func newCacheSalt(
+	externalConfig config.ExternalConfig,
+	flags Flags,
+) (cache.Salt, error) {
+	lint, err := json.Marshal(externalConfig.Lint)
+	if err != nil {
+		return "", err
+	}
+
+	parts := []string{
+		flags.Version,
+		binaryIdentity(),
+		string(lint),
+		fmt.Sprint(flags.PluginErrorAsFailure),
+	}
+	for _, p := range flags.Plugins {
+		if c, ok := p.(interface{ Command() string }); ok {
+			parts = append(parts, pluginIdentity(c.Command()))
+		}
+	}
+	return cache.NewSalt(parts...), nil
+}
+
+// binaryIdentity identifies the running protolint binary,
+// so that a development build without a release version doesn't reuse stale entries.
+func binaryIdentity() string {
+	var id []string
+	if info, ok := debug.ReadBuildInfo(); ok {
+		id = append(id, info.Main.Version)
+		for _, s := range info.Settings {
+			if strings.HasPrefix(s.Key, "vcs.") {
+				id = append(id, s.Key+"="+s.Value)
+			}
+		}
+	}
+	if path, err := os.Executable(); err == nil {
+		if stat, err := os.Stat(path); err == nil {
+			id = append(id, fmt.Sprint(stat.Size(), stat.ModTime().UnixNano()))
+		}
+	}
+	return strings.Join(id, ",")
+}
+
+// pluginIdentity identifies the plugin by the command and the content of its executable or module.
+func pluginIdentity(command string) string {
+	path, ok := pluginPath(command)
+	if !ok {
+		return command
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return command
+	}
+	sum := sha256.Sum256(content)
+	return command + "@" + hex.EncodeToString(sum[:])
+}
+
+// pluginPath resolves the file of the plugin.
+// A WASI module and a command with a path are read as is, because they need no exec bit to be found.
+func pluginPath(command string) (string, bool) {
+	if filepath.Ext(command) == ".wasm" {
+		return command, true
+	}
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return "", false
+	}
+	if strings.ContainsRune(fields[0], filepath.Separator) {
+		return fields[0], true
+	}
+	path, err := exec.LookPath(fields[0])
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// canCache decides whether or not the results of the rules can be cached.
+// The cache is bypassed in fix mode, and for the plugin rules which don't declare themselves deterministic.
+func (c CmdLintConfig) canCache(rules []rule.HasApply) bool {
+	if c.cache == nil || c.fixMode || c.autoDisableType != autodisable.Noop {
+		return false
+	}
+	for _, r := range rules {
+		if d, ok := internalrule.Unwrap(r).(rule.HasIsDeterministic); ok && !d.IsDeterministic() {
+			return false
+		}
+	}
+	return true
+}
+
+// lintWithCache returns the cached failures of the file if any.
+// Otherwise, it lints the file and caches the failures.
+// It skips parsing the file completely on a cache hit.
+func (c *CmdLint) lintWithCache(
+	f file.ProtoFile,
+	rules []rule.HasApply,
+	lint func() ([]report.Failure, error),
+) ([]report.Failure, error) {
+	if !c.config.canCache(rules) {
+		return lint()
+	}
+
+	content, err := f.Content()
+	if err != nil {
+		return nil, err
+	}
+	key := c.config.cacheSalt.NewKey(f.DisplayPath(), content)
+	if failures, ok := c.config.cache.Get(key); ok {
+		return failures, nil
+	}
+
+	failures, err := lint()
+	if err != nil {
+		return nil, err
+	}
+	if hasPluginError(failures) {
+		// A plugin error, like a timeout, may not happen again.
+		return failures, nil
+	}
+	if err := c.config.cache.Put(key, failures); err != nil && c.config.verbose {
+		log.Printf("[WARN] failed to write the cache of %s, err=%s\n", f.DisplayPath(), err)
+	}
+	return failures, nil
+}
+
+// hasPluginError checks whether or not any failure reports a plugin error by -plugin-error-as-failure.
+func hasPluginError(failures []report.Failure) bool {
+	for _, f := range failures {
+		if f.RuleID() == plugin.PluginErrorRuleID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin"
+	"github.com/yoheimuta/protolint/internal/linter/cache"
+	"github.com/yoheimuta/protolint/internal/linter/file"
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+func TestPluginIdentity(t *testing.T) {
+	dir := t.TempDir()
+	modulePath := filepath.Join(dir, "plugin.wasm")
+	commandPath := filepath.Join(dir, "plugin")
+	for _, path := range []string{modulePath, commandPath} {
+		// Neither file has the exec bit.
+		err := os.WriteFile(path, []byte("v1"), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, test := range []struct {
+		name       string
+		inputPath  string
+		inputCmd   string
+		wantHashed bool
+	}{
+		{
+			name:       "hash a WASI module without the exec bit",
+			inputPath:  modulePath,
+			inputCmd:   modulePath,
+			wantHashed: true,
+		},
+		{
+			name:       "hash a command with a path and arguments",
+			inputPath:  commandPath,
+			inputCmd:   commandPath + " -flag",
+			wantHashed: true,
+		},
+		{
+			name:     "keep the command not found",
+			inputCmd: "protolint-plugin-not-found",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := pluginIdentity(test.inputCmd)
+			if !test.wantHashed {
+				if got != test.inputCmd {
+					t.Errorf("got %s, but want %s", got, test.inputCmd)
+				}
+				return
+			}
+			if !strings.HasPrefix(got, test.inputCmd+"@") {
+				t.Fatalf("got %s, but want the hash of %s", got, test.inputPath)
+			}
+
+			err := os.WriteFile(test.inputPath, []byte("v2"), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated := pluginIdentity(test.inputCmd); updated == got {
+				t.Errorf("got the same identity %s after the file changes", updated)
+			}
+		})
+	}
+}
+
+func TestCmdLint_lintWithCache(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.proto")
+	err := os.WriteFile(path, []byte("syntax = \"proto3\";\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := file.NewProtoFile(path, path)
+
+	for _, test := range []struct {
+		name         string
+		inputRuleID  string
+		wantLintRuns int
+	}{
+		{
+			name:         "reuse the cached failures",
+			inputRuleID:  "ENUM_NAMES_UPPER_CAMEL_CASE",
+			wantLintRuns: 1,
+		},
+		{
+			name:         "don't cache the failures with a plugin error",
+			inputRuleID:  plugin.PluginErrorRuleID,
+			wantLintRuns: 2,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			c := &CmdLint{
+				config: CmdLintConfig{
+					cache:     cache.NewCache(t.TempDir()),
+					cacheSalt: cache.NewSalt("test"),
+				},
+			}
+			lintRuns := 0
+			lint := func() ([]report.Failure, error) {
+				lintRuns++
+				return []report.Failure{
+					report.Failuref(meta.Position{Filename: path}, test.inputRuleID, "message"),
+				}, nil
+			}
+
+			for i := 0; i < 2; i++ {
+				failures, err := c.lintWithCache(f, nil, lint)
+				if err != nil {
+					t.Fatalf("got err %v, but want nil", err)
+				}
+				if len(failures) != 1 || failures[0].RuleID() != test.inputRuleID {
+					t.Errorf("got %v, but want a failure of %s", failures, test.inputRuleID)
+				}
+			}
+			if lintRuns != test.wantLintRuns {
+				t.Errorf("got %d lint runs, but want %d", lintRuns, test.wantLintRuns)
+			}
+		})
+	}
+}
@@ -4,18 +4,26 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/hashicorp/go-plugin"
 
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
+	"github.com/yoheimuta/protolint/internal/addon/plugin/wasm"
+	"github.com/yoheimuta/protolint/internal/gitdiff"
 	"github.com/yoheimuta/protolint/internal/linter/config"
 
 	"github.com/yoheimuta/protolint/internal/linter"
 	"github.com/yoheimuta/protolint/internal/linter/file"
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/internal/linter/timing"
 	"github.com/yoheimuta/protolint/internal/osutil"
+	"github.com/yoheimuta/protolint/linter/autodisable"
 	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
 )
 
 // CmdLint is a lint command.
@@ -26,6 +34,18 @@ type CmdLint struct {
 	protoFiles []file.ProtoFile
 	config     CmdLintConfig
 	output     io.Writer
+	// stdinFile is the file read from stdin. It's nil unless -stdin is set.
+	stdinFile *file.ProtoFile
+	// changedLines is the lines to report failures at. It's nil unless -new-from-diff is set.
+	changedLines gitdiff.Changes
+	// flags is kept to collect the files and rebuild the config again in watch mode.
+	flags Flags
+	// timings is nil unless -timings is set.
+	timings *timing.Recorder
+	// renamedFiles maps the path of a file renamed by a fix to the new file.
+	renamedFiles map[string]file.ProtoFile
+	// stream reports the failures of each file as soon as it's linted. It's nil unless Run lints the files once.
+	stream *internalreport.Stream
 }
 
 // NewCmdLint creates a new CmdLint.
@@ -34,12 +54,128 @@ func NewCmdLint(
 	stdout io.Writer,
 	stderr io.Writer,
 ) (*CmdLint, error) {
+	var protoFiles []file.ProtoFile
+	var stdinFile *file.ProtoFile
+	var configSearchDirPath string
+	if flags.Stdin {
+		if 0 < len(flags.FilePaths) {
+			return nil, fmt.Errorf("-stdin can't be used together with the file paths %v", flags.FilePaths)
+		}
+		if flags.Watch {
+			return nil, fmt.Errorf("-stdin can't be used together with -watch")
+		}
+		content, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stdin, err=%s", err)
+		}
+		f, err := file.NewMemProtoFile(flags.StdinFilename, content)
+		if err != nil {
+			return nil, err
+		}
+		protoFiles = []file.ProtoFile{f}
+		stdinFile = &f
+		configSearchDirPath = filepath.Dir(f.Path())
+	} else {
+		var err error
+		protoFiles, err = collectProtoFiles(flags)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c, err := NewCmdLintForFiles(flags, protoFiles, configSearchDirPath, stdout, stderr)
+	if err != nil {
+		return nil, err
+	}
+	c.stdinFile = stdinFile
+	return c, nil
+}
+
+// NewCmdLintForFiles creates a new CmdLint which lints the protoFiles instead of the file paths in the flags.
+// The config file is searched from configSearchDirPath unless the flags specify it.
+// An empty configSearchDirPath means the working directory.
+func NewCmdLintForFiles(
+	flags Flags,
+	protoFiles []file.ProtoFile,
+	configSearchDirPath string,
+	stdout io.Writer,
+	stderr io.Writer,
+) (*CmdLint, error) {
+	externalConfig, err := loadExternalConfig(flags, configSearchDirPath)
+	if err != nil {
+		return nil, err
+	}
+	lintConfig, err := newValidatedCmdLintConfig(*externalConfig, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	var changedLines gitdiff.Changes
+	if flags.NewFromDiff != "" {
+		changedLines, err = gitdiff.NewChangedLines(flags.NewFromDiff)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	output := stderr
+
+	var timings *timing.Recorder
+	if flags.Timings {
+		timings = timing.NewRecorder()
+	}
+
+	return &CmdLint{
+		l:            linter.NewLinter(),
+		stdout:       stdout,
+		stderr:       stderr,
+		protoFiles:   protoFiles,
+		config:       lintConfig,
+		output:       output,
+		changedLines: changedLines,
+		flags:        flags,
+		timings:      timings,
+		renamedFiles: make(map[string]file.ProtoFile),
+	}, nil
+}
+
+// collectProtoFiles collects the proto files in the file paths given by the flags.
+func collectProtoFiles(flags Flags) ([]file.ProtoFile, error) {
 	protoSet, err := file.NewProtoSet(flags.FilePaths)
 	if err != nil {
 		return nil, err
 	}
+	return filterChangedSince(flags, protoSet.ProtoFiles())
+}
+
+// filterChangedSince drops the files which aren't changed since the ref if -changed-since is set.
+func filterChangedSince(
+	flags Flags,
+	protoFiles []file.ProtoFile,
+) ([]file.ProtoFile, error) {
+	if flags.ChangedSince == "" {
+		return protoFiles, nil
+	}
+	changedFiles, err := gitdiff.NewChangedFiles(flags.ChangedSince)
+	if err != nil {
+		return nil, err
+	}
+	var filtered []file.ProtoFile
+	for _, f := range protoFiles {
+		if changedFiles.HasFile(f.Path()) {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered, nil
+}
 
-	externalConfig, err := config.GetExternalConfig(flags.ConfigPath, flags.ConfigDirPath)
+// loadExternalConfig loads the config file specified by the flags, or found in searchDirPath or its parents.
+// It returns an empty config when no config file is found.
+func loadExternalConfig(
+	flags Flags,
+	searchDirPath string,
+) (*config.ExternalConfig, error) {
+	externalConfig, err := config.GetExternalConfigFrom(flags.ConfigPath, flags.ConfigDirPath, searchDirPath)
 	if err != nil {
 		return nil, err
 	}
@@ -53,57 +189,197 @@ func NewCmdLint(
 	if externalConfig == nil {
 		externalConfig = &(config.ExternalConfig{})
 	}
-	lintConfig := NewCmdLintConfig(
-		*externalConfig,
-		flags,
-	)
-
-	output := stderr
-
-	return &CmdLint{
-		l:          linter.NewLinter(),
-		stdout:     stdout,
-		stderr:     stderr,
-		protoFiles: protoSet.ProtoFiles(),
-		config:     lintConfig,
-		output:     output,
-	}, nil
+	return externalConfig, nil
 }
 
 // Run lints to proto files.
 func (c *CmdLint) Run() osutil.ExitCode {
 	defer plugin.CleanupClients()
+	defer wasm.CleanupClients()
 
-	failures, err := c.run()
+	stopProfiling, err := startProfiling(c.flags)
 	if err != nil {
 		_, _ = fmt.Fprintln(c.stderr, err)
 		return osutil.ExitInternalFailure
 	}
+	defer func() {
+		if err := stopProfiling(); err != nil {
+			_, _ = fmt.Fprintln(c.stderr, err)
+		}
+	}()
+	defer c.reportTimings()
 
-	err = c.config.reporters.ReportWithFallback(c.output, failures)
+	if c.flags.Watch {
+		return c.watch()
+	}
+
+	infos, err := c.config.RuleInfos()
 	if err != nil {
 		_, _ = fmt.Fprintln(c.stderr, err)
 		return osutil.ExitInternalFailure
 	}
+	c.stream = c.config.reporters.WithRuleInfos(infos).WithSources(c.readSource).NewStream(c.output)
+	defer c.stream.Close()
 
-	if 0 < len(failures) {
+	snapshot := c.snapshotDeclarations()
+	failures, results, err := c.run()
+	if err != nil {
+		if _, ok := err.(ParseError); ok {
+			// The reporters which describe the run report the file which can't be parsed, while the others report nothing.
+			abortErr := c.stream.Abort(c.runInfo(results))
+			if abortErr != nil {
+				_, _ = fmt.Fprintln(c.stderr, abortErr)
+			}
+		}
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	err = c.fixReferences(snapshot)
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+
+	// Write the fixed content to stdout because the file read from stdin can't be rewritten.
+	if c.stdinFile != nil && (c.config.fixMode || c.config.autoDisableType != autodisable.Noop) {
+		fixed, err := c.stdinFile.Content()
+		if err != nil {
+			_, _ = fmt.Fprintln(c.stderr, err)
+			return osutil.ExitInternalFailure
+		}
+		_, err = c.stdout.Write(fixed)
+		if err != nil {
+			_, _ = fmt.Fprintln(c.stderr, err)
+			return osutil.ExitInternalFailure
+		}
+	}
+
+	err = c.stream.Finish(c.runInfo(results))
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+
+	if len(failures) != report.CountFixed(failures) {
 		return osutil.ExitLintFailure
 	}
 
 	return osutil.ExitSuccess
 }
 
-func (c *CmdLint) run() ([]report.Failure, error) {
+// report reports the failures with all reporters at once, which describe the rules, the run and the sources if they can.
+func (c *CmdLint) report(
+	failures []report.Failure,
+	results []internalreport.FileResult,
+) error {
+	infos, err := c.config.RuleInfos()
+	if err != nil {
+		return err
+	}
+	return c.config.reporters.
+		WithRuleInfos(infos).
+		WithRunInfo(c.runInfo(results)).
+		WithSources(c.readSource).
+		ReportWithFallback(c.output, failures)
+}
+
+// readSource reads the linted file by the displayed path, so that the reporters show the in-memory content.
+// It falls back to the file on disk, for example when the file is renamed by a fix.
+func (c *CmdLint) readSource(path string) ([]byte, error) {
+	for _, f := range c.protoFiles {
+		if f.DisplayPath() == path {
+			return f.Content()
+		}
+	}
+	return os.ReadFile(path)
+}
+
+func (c *CmdLint) runInfo(results []internalreport.FileResult) internalreport.RunInfo {
+	return internalreport.RunInfo{
+		ToolVersion: c.flags.Version,
+		ConfigPath:  c.config.external.SourcePath,
+		Files:       results,
+	}
+}
+
+// Lint lints the files and returns the failures without reporting them.
+// Unlike Run, it keeps the plugins running, so that a long-running caller can reuse them.
+func (c *CmdLint) Lint() ([]report.Failure, error) {
+	failures, _, err := c.run()
+	return failures, err
+}
+
+// run lints the files and returns the failures and the results of the files.
+// It stops at the first parse error, and returns the results of the files up to the one which can't be parsed.
+func (c *CmdLint) run() ([]report.Failure, []internalreport.FileResult, error) {
 	var allFailures []report.Failure
+	var results []internalreport.FileResult
 
 	for _, f := range c.protoFiles {
-		failures, err := c.runOneFile(f)
+		failures, status, err := c.runOneFile(f)
 		if err != nil {
-			return nil, err
+			if _, ok := err.(ParseError); ok {
+				return nil, append(results, newFileResult(f, status, err)), err
+			}
+			return nil, nil, err
+		}
+		if c.config.fixMode {
+			failures, err = c.relint(f, failures)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		results = append(results, newFileResult(f, status, nil))
+		failures = c.filterByChangedLines(f, failures)
+		if c.stream != nil {
+			err = c.stream.ReportFile(failures)
+			if err != nil {
+				return nil, nil, err
+			}
 		}
 		allFailures = append(allFailures, failures...)
 	}
-	return allFailures, nil
+	return allFailures, results, nil
+}
+
+// newFileResult returns the result of the file linted with the status, or failed with the error.
+func newFileResult(
+	f file.ProtoFile,
+	status internalreport.FileStatus,
+	err error,
+) internalreport.FileResult {
+	result := internalreport.FileResult{
+		Path:   f.DisplayPath(),
+		Status: status,
+	}
+	if err != nil {
+		result.Status = internalreport.FileStatusError
+		if _, ok := err.(ParseError); ok {
+			result.Status = internalreport.FileStatusParseError
+		}
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// filterByChangedLines drops the failures outside the changed lines if -new-from-diff is set.
+// A failure without a line, which is about the whole file, is kept if the file is changed.
+func (c *CmdLint) filterByChangedLines(
+	f file.ProtoFile,
+	failures []report.Failure,
+) []report.Failure {
+	if c.changedLines == nil {
+		return failures
+	}
+
+	var filtered []report.Failure
+	for _, failure := range failures {
+		line := failure.Pos().Line
+		if (line == 0 && c.changedLines.HasFile(f.Path())) || c.changedLines.Contains(f.Path(), line) {
+			filtered = append(filtered, failure)
+		}
+	}
+	return filtered
 }
 
 // ParseError represents the error returned through a parsing exception.
@@ -115,19 +391,84 @@ func (p ParseError) Error() string {
 	return p.Message
 }
 
+// runOneFile lints the file and returns the failures and whether the file is linted or skipped.
 func (c *CmdLint) runOneFile(
 	f file.ProtoFile,
-) ([]report.Failure, error) {
+) ([]report.Failure, internalreport.FileStatus, error) {
 	// Gen rules first
 	// If there is no rule, we can skip parse proto file
 	rs, err := c.config.GenRules(f)
 	if err != nil {
-		return nil, err
+		return nil, internalreport.FileStatusError, err
 	}
 	if len(rs) == 0 {
-		return []report.Failure{}, nil
+		return []report.Failure{}, internalreport.FileStatusSkipped, nil
+	}
+
+	failures, err := c.lintWithCache(f, rs, func() ([]report.Failure, error) {
+		return c.lint(f, rs)
+	})
+	return failures, internalreport.FileStatusLinted, err
+}
+
+// relint lints the fixed file again without fixing it.
+// It returns the failures which remain, and the failures of the fix pass which are gone marked as fixed.
+func (c *CmdLint) relint(
+	f file.ProtoFile,
+	fixPassFailures []report.Failure,
+) ([]report.Failure, error) {
+	if renamed, ok := c.renamedFiles[f.Path()]; ok {
+		f = renamed
 	}
 
+	relinter := *c
+	relinter.config = c.config.withoutFix()
+	relinter.timings = nil
+	remaining, _, err := relinter.runOneFile(f)
+	if err != nil {
+		return nil, err
+	}
+	return markFixed(fixPassFailures, remaining), nil
+}
+
+// markFixed marks the failures of the fix pass which don't remain as fixed.
+// The failures are matched by the rule ID and the message because a fix can move the positions.
+func markFixed(
+	fixPassFailures []report.Failure,
+	remaining []report.Failure,
+) []report.Failure {
+	type key struct {
+		ruleID  string
+		message string
+	}
+	unmatched := make(map[key]int)
+	for _, r := range remaining {
+		unmatched[key{r.RuleID(), r.Message()}]++
+	}
+
+	var failures []report.Failure
+	for _, f := range fixPassFailures {
+		k := key{f.RuleID(), f.Message()}
+		if 0 < unmatched[k] {
+			unmatched[k]--
+			continue
+		}
+		failures = append(failures, f.WithFixed(true))
+	}
+	return append(failures, remaining...)
+}
+
+func (c *CmdLint) lint(
+	f file.ProtoFile,
+	rs []rule.HasApply,
+) ([]report.Failure, error) {
+	timingFile := f.DisplayPath()
+	originalPath := f.Path()
+	defer func() {
+		if f.Path() != originalPath {
+			c.renamedFiles[originalPath] = f
+		}
+	}()
 	return c.l.Run(func(p *parser.Proto) (*parser.Proto, error) {
 		// Recreate a protoFile if the previous rule changed the filename.
 		if p != nil && p.Meta.Filename != f.DisplayPath() {
@@ -136,7 +477,9 @@ func (c *CmdLint) runOneFile(
 			f = file.NewProtoFile(filepath.Join(filepath.Dir(f.Path()), newBase), newFilename)
 		}
 
+		start := time.Now()
 		proto, err := f.Parse(c.config.verbose)
+		c.recordParse(timingFile, start)
 		if err != nil {
 			if c.config.verbose {
 				return nil, ParseError{Message: err.Error()}
@@ -144,5 +487,5 @@ func (c *CmdLint) runOneFile(
 			return nil, ParseError{Message: fmt.Sprintf("%s. Use -v for more details", err)}
 		}
 		return proto, nil
-	}, rs)
+	}, c.withTimings(timingFile, rs))
 }
@@ -1,23 +1,34 @@
 package lint
 
 import (
+	"fmt"
+	"log"
+
 	"github.com/yoheimuta/protolint/internal/addon/plugin/shared"
 	"github.com/yoheimuta/protolint/internal/cmd/subcmds"
+	"github.com/yoheimuta/protolint/internal/linter/cache"
 	"github.com/yoheimuta/protolint/internal/linter/config"
 	"github.com/yoheimuta/protolint/internal/linter/file"
 	"github.com/yoheimuta/protolint/internal/linter/report"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/internal/stringsutil"
 	"github.com/yoheimuta/protolint/linter/autodisable"
 	"github.com/yoheimuta/protolint/linter/rule"
 )
 
 // CmdLintConfig is a config for lint command.
 type CmdLintConfig struct {
-	external        config.ExternalConfig
-	fixMode         bool
-	autoDisableType autodisable.PlacementType
-	verbose         bool
-	reporters       report.ReportersWithOutput
-	plugins         []shared.RuleSet
+	external             config.ExternalConfig
+	fixMode              bool
+	fixOnly              []string
+	autoDisableType      autodisable.PlacementType
+	verbose              bool
+	reporters            report.ReportersWithOutput
+	plugins              []shared.RuleSet
+	pluginErrorAsFailure bool
+	// cache is nil unless -cache is set.
+	cache     *cache.Cache
+	cacheSalt cache.Salt
 }
 
 // NewCmdLintConfig creates a new CmdLintConfig.
@@ -38,27 +49,144 @@ func NewCmdLintConfig(
 		reporters = append(reporters, r)
 	}
 
-	return CmdLintConfig{
-		external:        externalConfig,
-		fixMode:         flags.FixMode,
-		autoDisableType: flags.AutoDisableType,
-		verbose:         flags.Verbose,
-		reporters:       reporters,
-		plugins:         flags.Plugins,
+	// Layer the rule selection from the flags on the config, so that the cache is keyed by it.
+	externalConfig.Lint.Rules = externalConfig.Lint.Rules.WithSelection(
+		flags.Only,
+		append(append([]string{}, flags.Enable...), flags.FixOnly...),
+		flags.Disable,
+	)
+
+	c := CmdLintConfig{
+		external:             externalConfig,
+		fixMode:              flags.FixMode,
+		fixOnly:              flags.FixOnly,
+		autoDisableType:      flags.AutoDisableType,
+		verbose:              flags.Verbose,
+		reporters:            reporters,
+		plugins:              flags.Plugins,
+		pluginErrorAsFailure: flags.PluginErrorAsFailure,
+	}
+	if flags.Cache {
+		salt, err := newCacheSalt(externalConfig, flags)
+		if err != nil {
+			if flags.Verbose {
+				log.Printf("[WARN] protolint disables the cache, err=%s\n", err)
+			}
+			return c
+		}
+		c.cache = cache.NewCache(flags.CacheLocation)
+		c.cacheSalt = salt
+	}
+	return c
+}
+
+// newValidatedCmdLintConfig creates a new CmdLintConfig after validating the rule IDs,
+// and configures the reporters with the options in the config.
+func newValidatedCmdLintConfig(
+	externalConfig config.ExternalConfig,
+	flags Flags,
+) (CmdLintConfig, error) {
+	lintConfig := NewCmdLintConfig(externalConfig, flags)
+	err := lintConfig.validateRuleIDs(flags)
+	if err != nil {
+		return CmdLintConfig{}, err
+	}
+	return lintConfig.withReporterOptions()
+}
+
+// withReporterOptions configures the reporters with the options in the config.
+// The reporter template is given to the template reporters without one.
+// It's read only when a template reporter is selected, so that a broken template doesn't fail the other reporters.
+func (c CmdLintConfig) withReporterOptions() (CmdLintConfig, error) {
+	if c.reporters.HasTemplateReporter() {
+		text, err := c.external.Lint.ReporterTemplate.Text(c.external.SourcePath)
+		if err != nil {
+			return c, err
+		}
+		c.reporters, err = c.reporters.WithDefaultTemplate(text)
+		if err != nil {
+			return c, err
+		}
 	}
+	c.reporters = c.reporters.WithMarkdownOption(c.external.Lint.MarkdownReporter)
+	return c, nil
+}
+
+// withoutFix returns the config which only lints, to find the failures which remain after fixing.
+func (c CmdLintConfig) withoutFix() CmdLintConfig {
+	c.fixMode = false
+	c.fixOnly = nil
+	c.autoDisableType = autodisable.Noop
+	return c
+}
+
+// allRules creates all rules with the severities from the config.
+func (c CmdLintConfig) allRules() (internalrule.Rules, error) {
+	allRules, err := subcmds.NewAllRulesWithFixOnly(c.external.Lint.RulesOption, c.fixMode, c.fixOnly, c.autoDisableType, c.verbose, c.plugins, c.pluginErrorAsFailure)
+	if err != nil {
+		return nil, err
+	}
+	overrides, err := allRules.WithCategorySeverities(c.external.Lint.SeverityOverrides, c.external.Lint.CategorySeverities)
+	if err != nil {
+		return nil, err
+	}
+	return allRules.WithSeverityOverrides(overrides)
+}
+
+// RuleInfos returns the infos of all rules, which reporters use to describe the rules.
+func (c CmdLintConfig) RuleInfos() ([]internalrule.Info, error) {
+	allRules, err := c.allRules()
+	if err != nil {
+		return nil, err
+	}
+	return allRules.Infos(), nil
+}
+
+// validateRuleIDs checks that the rule IDs given by the flags and severity_overrides exist, including the ones of the plugins.
+// It also checks that the categories of category_severities exist.
+func (c CmdLintConfig) validateRuleIDs(flags Flags) error {
+	allRules, err := subcmds.NewAllRules(c.external.Lint.RulesOption, false, autodisable.Noop, c.verbose, c.plugins, c.pluginErrorAsFailure)
+	if err != nil {
+		return err
+	}
+	ids := allRules.IDs()
+	for _, f := range []struct {
+		name string
+		ids  []string
+	}{
+		{"enable", flags.Enable},
+		{"disable", flags.Disable},
+		{"only", flags.Only},
+		{"fix-only", flags.FixOnly},
+	} {
+		for _, id := range f.ids {
+			if !stringsutil.ContainsStringInSlice(id, ids) {
+				return fmt.Errorf("-%s got an unknown rule ID %s. Run `protolint list` to see all rules", f.name, id)
+			}
+		}
+	}
+	_, err = allRules.WithSeverityOverrides(c.external.Lint.SeverityOverrides)
+	if err != nil {
+		return err
+	}
+	_, err = allRules.WithCategorySeverities(c.external.Lint.SeverityOverrides, c.external.Lint.CategorySeverities)
+	return err
 }
 
 // GenRules generates rules which are applied to the filename path.
 func (c CmdLintConfig) GenRules(
 	f file.ProtoFile,
 ) ([]rule.HasApply, error) {
-	allRules, err := subcmds.NewAllRules(c.external.Lint.RulesOption, c.fixMode, c.autoDisableType, c.verbose, c.plugins)
+	allRules, err := c.allRules()
 	if err != nil {
 		return nil, err
 	}
 
+	external := c.external
+	external.Lint.Rules = external.Lint.Rules.WithCategories(allRules.IDsByCategory())
+
 	var defaultRuleIDs []string
-	if c.external.Lint.Rules.AllDefault {
+	if external.Lint.Rules.AllDefault {
 		defaultRuleIDs = allRules.IDs()
 	} else {
 		defaultRuleIDs = allRules.Default().IDs()
@@ -66,7 +194,7 @@ func (c CmdLintConfig) GenRules(
 
 	var hasApplies []rule.HasApply
 	for _, r := range allRules {
-		if c.external.ShouldSkipRule(r.ID(), f.DisplayPath(), defaultRuleIDs) {
+		if external.ShouldSkipRule(r.ID(), f.DisplayPath(), defaultRuleIDs) {
 			continue
 		}
 		hasApplies = append(hasApplies, r)
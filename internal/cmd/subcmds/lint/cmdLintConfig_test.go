@@ -0,0 +1,49 @@
+package lint
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/linter/config"
+	"github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+)
+
+func TestNewValidatedCmdLintConfig_reporterTemplate(t *testing.T) {
+	externalConfig := config.ExternalConfig{
+		SourcePath: filepath.Join(t.TempDir(), ".protolint.yaml"),
+		Lint: config.Lint{
+			ReporterTemplate: config.ReporterTemplate{File: "missing.tmpl"},
+		},
+	}
+
+	for _, test := range []struct {
+		name          string
+		inputReporter report.Reporter
+		wantExistErr  bool
+	}{
+		{
+			name:          "ignore the missing template without the template reporter",
+			inputReporter: reporters.PlainReporter{},
+		},
+		{
+			name:          "fail with the missing template for the template reporter",
+			inputReporter: reporters.TemplateReporter{},
+			wantExistErr:  true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := newValidatedCmdLintConfig(externalConfig, Flags{Reporter: test.inputReporter})
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+			}
+		})
+	}
+}
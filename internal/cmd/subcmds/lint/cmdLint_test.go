@@ -0,0 +1,56 @@
+package lint
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+)
+
+func TestCmdLint_runStopsAtParseError(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		inputReporter internalreport.Reporter
+	}{
+		{
+			name:          "plain reporter",
+			inputReporter: reporters.PlainReporter{},
+		},
+		{
+			name:          "reporter which describes the run",
+			inputReporter: reporters.JSONV2Reporter{},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeWatchTestFile(t, filepath.Join(dir, ".protolint.yaml"), watchTestConfig)
+			writeWatchTestFile(t, filepath.Join(dir, "a.proto"), "syntax = \"proto3\";\nmessage {\n")
+			writeWatchTestFile(t, filepath.Join(dir, "b.proto"), watchTestBadProto)
+
+			flags := Flags{
+				FilePaths:     []string{dir},
+				ConfigDirPath: dir,
+				Reporter:      test.inputReporter,
+			}
+			protoFiles, err := collectProtoFiles(flags)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c, err := NewCmdLintForFiles(flags, protoFiles, dir, io.Discard, io.Discard)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, results, err := c.run()
+			if _, ok := err.(ParseError); !ok {
+				t.Errorf("got err %v, but want ParseError", err)
+			}
+			if len(results) != 1 || results[0].Status != internalreport.FileStatusParseError {
+				t.Errorf("got %v, but want only the result of a.proto which can't be parsed", results)
+			}
+		})
+	}
+}
@@ -2,8 +2,11 @@ package lint
 
 import (
 	"flag"
+	"fmt"
+	"time"
 
 	"github.com/yoheimuta/protolint/internal/cmd/subcmds"
+	"github.com/yoheimuta/protolint/internal/linter/cache"
 	"github.com/yoheimuta/protolint/linter/autodisable"
 
 	"github.com/yoheimuta/protolint/internal/addon/plugin/shared"
@@ -13,6 +16,9 @@ import (
 	"github.com/yoheimuta/protolint/internal/linter/report"
 )
 
+// defaultStdinFilename is the file name of the content read from stdin when -stdin-filename isn't set.
+const defaultStdinFilename = "stdin.proto"
+
 // Flags represents a set of lint flag parameters.
 type Flags struct {
 	*flag.FlagSet
@@ -27,7 +33,27 @@ type Flags struct {
 	Verbose                   bool
 	NoErrorOnUnmatchedPattern bool
 	Plugins                   []shared.RuleSet
+	PluginTimeout             time.Duration
+	PluginErrorAsFailure      bool
 	AdditionalReporters       reporterStreamFlags
+	Stdin                     bool
+	StdinFilename             string
+	ChangedSince              string
+	NewFromDiff               string
+	Watch                     bool
+	Cache                     bool
+	CacheLocation             string
+	Timings                   bool
+	TimingsFormat             string
+	CPUProfile                string
+	MemProfile                string
+	Enable                    []string
+	Disable                   []string
+	Only                      []string
+	FixOnly                   []string
+	FixReferences             bool
+	// Version is the protolint version, which keys the cache. It's set by the caller, not by a flag.
+	Version string
 }
 
 // NewFlags creates a new Flags.
@@ -43,6 +69,7 @@ func NewFlags(
 	var af autoDisableFlag
 	var pf subcmds.PluginFlag
 	var rfs reporterStreamFlags
+	var enable, disable, only, fixOnly ruleIDsFlag
 
 	f.StringVar(
 		&f.ConfigPath,
@@ -65,7 +92,7 @@ func NewFlags(
 	f.Var(
 		&rf,
 		"reporter",
-		`formatter to output results in the specific format. Available reporters are "plain"(default), "junit", "json", "sarif", and "unix".`,
+		`formatter to output results in the specific format. Available reporters are "plain"(default), "pretty", "junit", "checkstyle", "codeclimate", "html", "markdown", "json", "json-v2", "ndjson", "sarif", "sonar", "sonar-v2", "template:path/to/tmpl", and "unix". "template" alone uses reporter_template in the config file.`,
 	)
 	f.Var(
 		&af,
@@ -81,7 +108,19 @@ func NewFlags(
 	f.Var(
 		&pf,
 		"plugin",
-		`plugins to provide custom lint rule set. Note that it's necessary to specify it as path format'`,
+		`plugins to provide custom lint rule set. Note that it's necessary to specify it as path format'. A path ending with .wasm is loaded as a sandboxed WASI module`,
+	)
+	f.DurationVar(
+		&f.PluginTimeout,
+		"plugin-timeout",
+		subcmds.DefaultPluginTimeout,
+		"time limit of each call to a plugin. A plugin which exceeds it is killed and restarted on the next call. 0 means no limit",
+	)
+	f.BoolVar(
+		&f.PluginErrorAsFailure,
+		"plugin-error-as-failure",
+		false,
+		"reports a plugin error as a PLUGIN_ERROR lint failure instead of aborting with an internal error",
 	)
 	f.BoolVar(
 		&f.Verbose,
@@ -95,6 +134,98 @@ func NewFlags(
 		false,
 		"exits with 0 when no file is matched",
 	)
+	f.BoolVar(
+		&f.Stdin,
+		"stdin",
+		false,
+		"lints the content read from stdin instead of files. With -fix, the fixed content is written to stdout",
+	)
+	f.StringVar(
+		&f.StdinFilename,
+		"stdin-filename",
+		defaultStdinFilename,
+		"path/to/file.proto of the content read from stdin. The config file, ignores and directory excludes are resolved against it",
+	)
+	f.StringVar(
+		&f.ChangedSince,
+		"changed-since",
+		"",
+		"lints only the files changed in the working tree since the git ref, including untracked files",
+	)
+	f.StringVar(
+		&f.NewFromDiff,
+		"new-from-diff",
+		"",
+		"reports only the failures at the lines changed since the git ref, or the lines added by the unified diff file at the path. A git ref takes precedence over a file with the same name",
+	)
+	f.BoolVar(
+		&f.Watch,
+		"watch",
+		false,
+		"keeps running and lints the changed files again whenever the files or the config file change",
+	)
+	f.BoolVar(
+		&f.Cache,
+		"cache",
+		false,
+		"caches the results of the unchanged files across runs. The cache is bypassed in fix mode and for nondeterministic plugin rules",
+	)
+	f.StringVar(
+		&f.CacheLocation,
+		"cache-location",
+		cache.DefaultLocation,
+		"path/to/the_cache_directory",
+	)
+	f.BoolVar(
+		&f.Timings,
+		"timings",
+		false,
+		"reports the time spent by parsing and each rule, including plugins, per file to stderr",
+	)
+	f.StringVar(
+		&f.TimingsFormat,
+		"timings-format",
+		timingsFormatText,
+		`format of the timings. Available formats are "text"(default) and "json"`,
+	)
+	f.StringVar(
+		&f.CPUProfile,
+		"cpuprofile",
+		"",
+		"path/to/cpu.pprof to write a CPU profile",
+	)
+	f.StringVar(
+		&f.MemProfile,
+		"memprofile",
+		"",
+		"path/to/mem.pprof to write a memory profile at the end",
+	)
+	f.Var(
+		&enable,
+		"enable",
+		"enables the rule in addition to the config. It can be repeated or comma-separated",
+	)
+	f.Var(
+		&disable,
+		"disable",
+		"disables the rule in addition to the config. It can be repeated or comma-separated",
+	)
+	f.Var(
+		&only,
+		"only",
+		"enables only the rules, ignoring the enabled rules in the config. It can be repeated or comma-separated",
+	)
+	f.Var(
+		&fixOnly,
+		"fix-only",
+		"enables the rule and fixes only its problems, while the other rules only report. It implies -fix. It can be repeated or comma-separated",
+	)
+	f.BoolVar(
+		&f.FixReferences,
+		"fix-references",
+		false,
+		"rewrites the references in all the files to the messages and the enums renamed by -fix, so that the other files keep referring to them",
+	)
 	f.Var(
 		&rfs,
 		"add-reporter",
@@ -111,8 +242,28 @@ func NewFlags(
 	if af.autoDisableType != 0 {
 		f.AutoDisableType = af.autoDisableType
 	}
+	f.Enable = enable
+	f.Disable = disable
+	f.Only = only
+	f.FixOnly = fixOnly
+	if 0 < len(f.FixOnly) {
+		f.FixMode = true
+	}
+
+	if f.FixReferences && !f.FixMode {
+		return Flags{}, fmt.Errorf("-fix-references requires -fix or -fix-only")
+	}
+	if f.FixReferences && f.Watch {
+		return Flags{}, fmt.Errorf("-fix-references can't be used together with -watch")
+	}
+
+	switch f.TimingsFormat {
+	case timingsFormatText, timingsFormatJSON:
+	default:
+		return Flags{}, fmt.Errorf("%s is an invalid timings format. valid format is text or json", f.TimingsFormat)
+	}
 
-	plugins, err := pf.BuildPlugins(f.Verbose)
+	plugins, err := pf.BuildPlugins(f.Verbose, f.PluginTimeout)
 	if err != nil {
 		return Flags{}, err
 	}
@@ -0,0 +1,64 @@
+package lint
+
+import (
+	"log"
+
+	"github.com/yoheimuta/protolint/internal/linter/file"
+	"github.com/yoheimuta/protolint/internal/linter/rename"
+)
+
+// snapshotDeclarations records the messages and the enums of each file before fixing if -fix-references is set.
+// A file which fails to parse is left out, and the lint reports the error.
+func (c *CmdLint) snapshotDeclarations() map[string][]string {
+	if !c.flags.FixReferences {
+		return nil
+	}
+	snapshot := make(map[string][]string)
+	for _, f := range c.protoFiles {
+		declarations, err := rename.Declarations(f)
+		if err != nil {
+			continue
+		}
+		snapshot[f.Path()] = declarations
+	}
+	return snapshot
+}
+
+// fixReferences rewrites the references to the messages and the enums renamed by the fixes across the files.
+func (c *CmdLint) fixReferences(snapshot map[string][]string) error {
+	if snapshot == nil {
+		return nil
+	}
+
+	var files []file.ProtoFile
+	var renamings []rename.Renaming
+	for _, f := range c.protoFiles {
+		before, ok := snapshot[f.Path()]
+		if renamed, ok := c.renamedFiles[f.Path()]; ok {
+			f = renamed
+		}
+		files = append(files, f)
+		if !ok {
+			continue
+		}
+		after, err := rename.Declarations(f)
+		if err != nil {
+			return err
+		}
+		renamings = append(renamings, rename.Diff(before, after)...)
+	}
+	if len(renamings) == 0 {
+		return nil
+	}
+
+	changes, err := rename.RewriteReferences(files, renamings)
+	if err != nil {
+		return err
+	}
+	if c.config.verbose {
+		for _, change := range changes {
+			log.Printf("[INFO] protolint rewrote %d reference(s) in %s\n", change.Edits, change.File.DisplayPath())
+		}
+	}
+	return rename.Apply(changes)
+}
@@ -36,6 +36,13 @@ func (f *reporterStreamFlag) Set(value string) error {
 
 	reporterName := valueSplit[0]
 	outputFile := valueSplit[1]
+	if reporterName == "template" {
+		// template:path/to/tmpl:path/to/output, or template:path/to/output to use the template in the config.
+		if templateSplit := strings.SplitN(outputFile, ":", 2); len(templateSplit) == 2 {
+			reporterName += ":" + templateSplit[0]
+			outputFile = templateSplit[1]
+		}
+	}
 
 	r, err := GetReporter(reporterName)
 	if err != nil {
@@ -88,23 +95,36 @@ func (f *reporterFlag) Set(value string) error {
 }
 
 // GetReporter returns a reporter from the specified key.
+// The key "template:path/to/tmpl" returns the template reporter with the template in the file.
 func GetReporter(value string) (report.Reporter, error) {
+	if path, ok := strings.CutPrefix(value, "template:"); ok {
+		return reporters.NewTemplateReporterFromFile(path)
+	}
 	rs := map[string]report.Reporter{
-		"plain":   reporters.PlainReporter{},
-		"junit":   reporters.JUnitReporter{},
-		"unix":    reporters.UnixReporter{},
-		"json":    reporters.JSONReporter{},
-		"sarif":   reporters.SarifReporter{},
-		"sonar":   reporters.SonarReporter{},
-		"tsc":     reporters.TscReporter{},
-		"ci":      reporters.NewCiReporterWithGenericFormat(),
-		"ci-az":   reporters.NewCiReporterForAzureDevOps(),
-		"ci-gh":   reporters.NewCiReporterForGithubActions(),
-		"ci-glab": reporters.NewCiReporterForGitlab(),
-		"ci-env":  reporters.NewCiReporterFromEnv(),
+		"plain":       reporters.PlainReporter{},
+		"pretty":      reporters.PrettyReporter{},
+		"junit":       reporters.JUnitReporter{},
+		"checkstyle":  reporters.CheckstyleReporter{},
+		"codeclimate": reporters.CodeClimateReporter{},
+		"html":        reporters.HTMLReporter{},
+		"markdown":    reporters.MarkdownReporter{},
+		"unix":        reporters.UnixReporter{},
+		"json":        reporters.JSONReporter{},
+		"json-v2":     reporters.JSONV2Reporter{},
+		"ndjson":      reporters.NewNDJSONReporter(),
+		"sarif":       reporters.SarifReporter{},
+		"sonar":       reporters.SonarReporter{},
+		"sonar-v2":    reporters.SonarV2Reporter{},
+		"tsc":         reporters.TscReporter{},
+		"template":    reporters.NewTemplateReporter(),
+		"ci":          reporters.NewCiReporterWithGenericFormat(),
+		"ci-az":       reporters.NewCiReporterForAzureDevOps(),
+		"ci-gh":       reporters.NewCiReporterForGithubActions(),
+		"ci-glab":     reporters.NewCiReporterForGitlab(),
+		"ci-env":      reporters.NewCiReporterFromEnv(),
 	}
 	if r, ok := rs[value]; ok {
 		return r, nil
 	}
-	return nil, fmt.Errorf(`available reporters are "plain", "junit", "json", "sarif", and "unix, available reporters for CI/CD are ci, ci-az, ci-gh, ci-glab"`)
+	return nil, fmt.Errorf(`available reporters are "plain", "pretty", "junit", "checkstyle", "codeclimate", "html", "markdown", "json", "json-v2", "ndjson", "sarif", "sonar", "sonar-v2", "template:path/to/tmpl", and "unix, available reporters for CI/CD are ci, ci-az, ci-gh, ci-glab"`)
 }
@@ -0,0 +1,22 @@
+package lint
+
+import (
+	"strings"
+)
+
+// ruleIDsFlag is a flag for rule IDs. It can be repeated, and each value can be a comma-separated list.
+type ruleIDsFlag []string
+
+func (f *ruleIDsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *ruleIDsFlag) Set(value string) error {
+	for _, id := range strings.Split(value, ",") {
+		id = strings.TrimSpace(id)
+		if id != "" {
+			*f = append(*f, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/yoheimuta/protolint/internal/linter/timing"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// The formats of -timings-format.
+const (
+	timingsFormatText = "text"
+	timingsFormatJSON = "json"
+)
+
+// timedRule records the time spent by Apply, including the round trip to a plugin.
+type timedRule struct {
+	rule.HasApply
+	id       string
+	file     string
+	recorder *timing.Recorder
+}
+
+// Apply applies the rule to the proto, and records the time spent.
+func (r timedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	start := time.Now()
+	defer func() {
+		r.recorder.Record(r.file, r.id, time.Since(start))
+	}()
+	return r.HasApply.Apply(proto)
+}
+
+// withTimings returns the rules which record the time spent on the file if -timings is set.
+func (c *CmdLint) withTimings(
+	file string,
+	rs []rule.HasApply,
+) []rule.HasApply {
+	if c.timings == nil {
+		return rs
+	}
+
+	var timed []rule.HasApply
+	for _, r := range rs {
+		id := "(unknown)"
+		if i, ok := r.(rule.HasID); ok {
+			id = i.ID()
+		}
+		timed = append(timed, timedRule{
+			HasApply: r,
+			id:       id,
+			file:     file,
+			recorder: c.timings,
+		})
+	}
+	return timed
+}
+
+// recordParse records the time spent by parsing the file if -timings is set.
+func (c *CmdLint) recordParse(
+	file string,
+	start time.Time,
+) {
+	if c.timings == nil {
+		return
+	}
+	c.timings.Record(file, timing.Parse, time.Since(start))
+}
+
+// reportTimings writes the summary of the timings to stderr if -timings is set.
+func (c *CmdLint) reportTimings() {
+	if c.timings == nil {
+		return
+	}
+
+	summary := c.timings.Summary()
+	var err error
+	if c.flags.TimingsFormat == timingsFormatJSON {
+		err = summary.WriteJSON(c.stderr)
+	} else {
+		err = summary.WriteText(c.stderr)
+	}
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+	}
+}
+
+// startProfiling starts the CPU profiling if -cpuprofile is set.
+// The returned function stops it, and writes the heap profile if -memprofile is set.
+func startProfiling(flags Flags) (func() error, error) {
+	var cpuFile *os.File
+	if flags.CPUProfile != "" {
+		var err error
+		cpuFile, err = os.Create(flags.CPUProfile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the cpu profile, err=%s", err)
+		}
+		err = pprof.StartCPUProfile(cpuFile)
+		if err != nil {
+			_ = cpuFile.Close()
+			return nil, fmt.Errorf("failed to start the cpu profile, err=%s", err)
+		}
+	}
+
+	return func() error {
+		if cpuFile != nil {
+			pprof.StopCPUProfile()
+			err := cpuFile.Close()
+			if err != nil {
+				return fmt.Errorf("failed to write the cpu profile, err=%s", err)
+			}
+		}
+		if flags.MemProfile != "" {
+			return writeMemProfile(flags.MemProfile)
+		}
+		return nil
+	}, nil
+}
+
+func writeMemProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create the memory profile, err=%s", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	// Get up-to-date statistics of the allocations.
+	runtime.GC()
+	err = pprof.WriteHeapProfile(f)
+	if err != nil {
+		return fmt.Errorf("failed to write the memory profile, err=%s", err)
+	}
+	return nil
+}
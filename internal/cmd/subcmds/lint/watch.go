@@ -0,0 +1,305 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/yoheimuta/protolint/internal/gitdiff"
+	"github.com/yoheimuta/protolint/internal/linter/config"
+	"github.com/yoheimuta/protolint/internal/linter/file"
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/internal/osutil"
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+// watchInterval is the interval to check the files for changes.
+const watchInterval = 500 * time.Millisecond
+
+// clearScreen moves the cursor to the top-left and clears the terminal.
+const clearScreen = "\033[H\033[2J"
+
+// fileStamp identifies the version of a file.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func statFile(path string) (fileStamp, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}, false
+	}
+	return fileStamp{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}, true
+}
+
+// watchResult is the last result of linting a file.
+type watchResult struct {
+	failures []report.Failure
+	err      error
+	file     internalreport.FileResult
+	// stamp is the stamp of the file when it's linted.
+	stamp fileStamp
+}
+
+// watcher keeps the state of watch mode between the checks.
+type watcher struct {
+	c *CmdLint
+
+	configPath  string
+	configStamp fileStamp
+	// dirStamps holds the stamps of the target paths and the directories under them.
+	// The files are collected again only when one of them changes, because it means that a file is added or removed.
+	dirStamps map[string]fileStamp
+	// allFiles holds the proto files in the target paths, before they are filtered by -changed-since.
+	allFiles []file.ProtoFile
+	// protoFiles holds the proto files to lint.
+	protoFiles []file.ProtoFile
+	// stamps holds the stamps of allFiles.
+	stamps  map[string]fileStamp
+	results map[string]watchResult
+}
+
+func newWatcher(c *CmdLint) *watcher {
+	w := &watcher{
+		c:       c,
+		stamps:  make(map[string]fileStamp),
+		results: make(map[string]watchResult),
+	}
+	w.configPath = c.config.external.SourcePath
+	w.configStamp, _ = statFile(w.configPath)
+	return w
+}
+
+// watch lints the files and keeps linting the changed files again until interrupted.
+// Plugins keep running between the runs.
+func (c *CmdLint) watch() osutil.ExitCode {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	w := newWatcher(c)
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	first := true
+	var lastConfigErr string
+	for {
+		configChanged, err := w.reloadConfigIfChanged()
+		if err != nil {
+			// Redraw only once for the same error, for example while the config file is being edited.
+			if err.Error() != lastConfigErr {
+				lastConfigErr = err.Error()
+				w.redraw(err)
+			}
+		} else if w.relint(configChanged) || first || configChanged || lastConfigErr != "" {
+			lastConfigErr = ""
+			w.redraw(nil)
+		}
+		first = false
+
+		select {
+		case <-ctx.Done():
+			return osutil.ExitSuccess
+		case <-ticker.C:
+		}
+	}
+}
+
+// reloadConfigIfChanged rebuilds the config when the config file is changed, created or removed.
+func (w *watcher) reloadConfigIfChanged() (bool, error) {
+	flags := w.c.flags
+	stamp, _ := statFile(w.configPath)
+	if w.configPath != "" && stamp == w.configStamp {
+		return false, nil
+	}
+
+	externalConfig, err := config.GetExternalConfig(flags.ConfigPath, flags.ConfigDirPath)
+	if err != nil {
+		return false, err
+	}
+	if externalConfig == nil {
+		if w.configPath == "" {
+			return false, nil
+		}
+		externalConfig = &(config.ExternalConfig{})
+	}
+
+	w.configPath = externalConfig.SourcePath
+	w.configStamp, _ = statFile(w.configPath)
+	lintConfig, err := newValidatedCmdLintConfig(*externalConfig, flags)
+	if err != nil {
+		return false, err
+	}
+	w.c.config = lintConfig
+	return true, nil
+}
+
+// relint lints the files changed since the last check, or all files when all is true.
+// It returns true if any result may have changed.
+func (w *watcher) relint(all bool) bool {
+	collected := w.collectIfChanged()
+
+	var modified bool
+	found := make(map[string]bool)
+	for _, f := range w.allFiles {
+		found[f.Path()] = true
+		stamp, ok := statFile(f.Path())
+		if !ok {
+			continue
+		}
+		if prev, seen := w.stamps[f.Path()]; !seen || prev != stamp {
+			w.stamps[f.Path()] = stamp
+			modified = true
+		}
+	}
+	for path := range w.stamps {
+		if !found[path] {
+			delete(w.stamps, path)
+		}
+	}
+	// A modified file can change the result of git, so the files and the lines are filtered again only then.
+	if collected || modified {
+		protoFiles, err := filterChangedSince(w.c.flags, w.allFiles)
+		if err == nil {
+			w.protoFiles = protoFiles
+		}
+		if w.c.flags.NewFromDiff != "" {
+			changedLines, err := gitdiff.NewChangedLines(w.c.flags.NewFromDiff)
+			if err == nil {
+				w.c.changedLines = changedLines
+			}
+		}
+	}
+
+	changed := false
+	targets := make(map[string]bool)
+	for _, f := range w.protoFiles {
+		targets[f.Path()] = true
+
+		stamp, ok := w.stamps[f.Path()]
+		if !ok {
+			continue
+		}
+		if prev, seen := w.results[f.Path()]; seen && prev.stamp == stamp && !all {
+			continue
+		}
+		w.lint(f)
+		changed = true
+	}
+
+	for path := range w.results {
+		if !targets[path] {
+			delete(w.results, path)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// collectIfChanged collects the proto files again if the target paths or the directories under them change.
+// It returns true if it collects them.
+func (w *watcher) collectIfChanged() bool {
+	if w.dirStamps != nil && !w.dirsChanged() {
+		return false
+	}
+
+	w.dirStamps = statDirs(w.c.flags.FilePaths)
+	protoSet, err := file.NewProtoSet(w.c.flags.FilePaths)
+	if err != nil {
+		w.allFiles = nil
+	} else {
+		w.allFiles = protoSet.ProtoFiles()
+	}
+	return true
+}
+
+func (w *watcher) dirsChanged() bool {
+	for path, prev := range w.dirStamps {
+		if stamp, ok := statFile(path); !ok || stamp != prev {
+			return true
+		}
+	}
+	return false
+}
+
+// statDirs returns the stamps of the paths and the directories under them.
+func statDirs(paths []string) map[string]fileStamp {
+	stamps := make(map[string]fileStamp)
+	for _, path := range paths {
+		if stamp, ok := statFile(path); ok {
+			stamps[path] = stamp
+		}
+		_ = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+			if err != nil || !info.IsDir() {
+				return nil
+			}
+			stamps[p] = fileStamp{
+				modTime: info.ModTime(),
+				size:    info.Size(),
+			}
+			return nil
+		})
+	}
+	return stamps
+}
+
+func (w *watcher) lint(f file.ProtoFile) {
+	failures, status, err := w.c.runOneFile(f)
+	// Keep the stamp after linting, because fixing rewrites the file.
+	stamp, _ := statFile(f.Path())
+	w.stamps[f.Path()] = stamp
+	w.results[f.Path()] = watchResult{
+		failures: w.c.filterByChangedLines(f, failures),
+		err:      err,
+		file:     newFileResult(f, status, err),
+		stamp:    stamp,
+	}
+}
+
+// redraw clears the screen and reports all the current results.
+func (w *watcher) redraw(configErr error) {
+	c := w.c
+	_, _ = fmt.Fprint(c.output, clearScreen)
+
+	if configErr != nil {
+		_, _ = fmt.Fprintln(c.stderr, configErr)
+	}
+
+	var paths []string
+	for path := range w.results {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var failures []report.Failure
+	var results []internalreport.FileResult
+	for _, path := range paths {
+		failures = append(failures, w.results[path].failures...)
+		results = append(results, w.results[path].file)
+	}
+	err := c.report(failures, results)
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+	}
+	for _, path := range paths {
+		if err := w.results[path].err; err != nil {
+			_, _ = fmt.Fprintln(c.stderr, err)
+		}
+	}
+
+	_, _ = fmt.Fprintf(
+		c.stderr,
+		"\n[%s] Found %d failures in %d files. Watching for changes...\n",
+		time.Now().Format("15:04:05"),
+		len(failures),
+		len(paths),
+	)
+}
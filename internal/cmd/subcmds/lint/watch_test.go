@@ -0,0 +1,274 @@
+package lint
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+)
+
+const (
+	watchTestConfig = `lint:
+  rules:
+    no_default: true
+    add:
+      - ENUM_FIELD_NAMES_UPPER_SNAKE_CASE
+`
+	watchTestBadProto  = "syntax = \"proto3\";\nenum A {\n  a_zero = 0;\n}\n"
+	watchTestGoodProto = "syntax = \"proto3\";\nenum A {\n  A_ZERO_VALUE = 0;\n}\n"
+)
+
+func writeWatchTestFile(t *testing.T, path string, content string) {
+	t.Helper()
+	err := os.WriteFile(path, []byte(content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newTestWatcher creates a watcher of dir, which has the config file.
+func newTestWatcher(t *testing.T, dir string) *watcher {
+	t.Helper()
+	return newTestWatcherWithFlags(t, dir, Flags{})
+}
+
+// newTestWatcherWithFlags creates a watcher of dir with the flags, which are completed to lint dir with the config file.
+func newTestWatcherWithFlags(t *testing.T, dir string, flags Flags) *watcher {
+	t.Helper()
+	writeWatchTestFile(t, filepath.Join(dir, ".protolint.yaml"), watchTestConfig)
+
+	flags.FilePaths = []string{dir}
+	flags.ConfigDirPath = dir
+	flags.Reporter = reporters.PlainReporter{}
+	c, err := NewCmdLintForFiles(flags, nil, dir, io.Discard, io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newWatcher(c)
+}
+
+// failureCounts returns the number of failures keyed by the base name of each linted file.
+func failureCounts(w *watcher) map[string]int {
+	counts := make(map[string]int)
+	for path, result := range w.results {
+		counts[filepath.Base(path)] = len(result.failures)
+	}
+	return counts
+}
+
+func TestWatcher_relint(t *testing.T) {
+	dir := t.TempDir()
+	aPath := filepath.Join(dir, "a.proto")
+	bPath := filepath.Join(dir, "b.proto")
+	writeWatchTestFile(t, aPath, watchTestBadProto)
+	w := newTestWatcher(t, dir)
+
+	for _, test := range []struct {
+		name          string
+		inputChange   func()
+		inputAll      bool
+		wantCollected bool
+		wantChanged   bool
+		wantCounts    map[string]int
+	}{
+		{
+			name:          "lint all files at first",
+			inputChange:   func() {},
+			wantCollected: true,
+			wantChanged:   true,
+			wantCounts:    map[string]int{"a.proto": 1},
+		},
+		{
+			name:        "neither collect nor lint without changes",
+			inputChange: func() {},
+			wantCounts:  map[string]int{"a.proto": 1},
+		},
+		{
+			name:        "lint all files again without collecting them",
+			inputChange: func() {},
+			inputAll:    true,
+			wantChanged: true,
+			wantCounts:  map[string]int{"a.proto": 1},
+		},
+		{
+			name: "lint the modified file without collecting the files",
+			inputChange: func() {
+				writeWatchTestFile(t, aPath, watchTestGoodProto)
+			},
+			wantChanged: true,
+			wantCounts:  map[string]int{"a.proto": 0},
+		},
+		{
+			name: "collect the files when a file is added",
+			inputChange: func() {
+				writeWatchTestFile(t, bPath, watchTestBadProto)
+			},
+			wantCollected: true,
+			wantChanged:   true,
+			wantCounts:    map[string]int{"a.proto": 0, "b.proto": 1},
+		},
+		{
+			name: "collect the files when a file is removed",
+			inputChange: func() {
+				err := os.Remove(aPath)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantCollected: true,
+			wantChanged:   true,
+			wantCounts:    map[string]int{"b.proto": 1},
+		},
+		{
+			name: "collect the files when a file is added to a new directory",
+			inputChange: func() {
+				subDir := filepath.Join(dir, "sub")
+				err := os.Mkdir(subDir, 0755)
+				if err != nil {
+					t.Fatal(err)
+				}
+				writeWatchTestFile(t, filepath.Join(subDir, "c.proto"), watchTestBadProto)
+			},
+			wantCollected: true,
+			wantChanged:   true,
+			wantCounts:    map[string]int{"b.proto": 1, "c.proto": 1},
+		},
+	} {
+		test.inputChange()
+
+		dirStamps := w.dirStamps
+		gotChanged := w.relint(test.inputAll)
+		gotCollected := !reflect.DeepEqual(dirStamps, w.dirStamps)
+		if gotCollected != test.wantCollected {
+			t.Errorf("%s: got collected %v, but want %v", test.name, gotCollected, test.wantCollected)
+		}
+		if gotChanged != test.wantChanged {
+			t.Errorf("%s: got changed %v, but want %v", test.name, gotChanged, test.wantChanged)
+		}
+		if got := failureCounts(w); !reflect.DeepEqual(got, test.wantCounts) {
+			t.Errorf("%s: got %v, but want %v", test.name, got, test.wantCounts)
+		}
+	}
+}
+
+func TestWatcher_relintWithNewFromDiff(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("skip because git isn't installed")
+	}
+
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	aPath := filepath.Join(dir, "a.proto")
+	writeWatchTestFile(t, aPath, watchTestGoodProto)
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "initial"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("failed git %v, err=%v, out=%s", args, err, out)
+		}
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chdir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.Chdir(cwd)
+	}()
+
+	w := newTestWatcherWithFlags(t, dir, Flags{NewFromDiff: "HEAD"})
+	w.relint(true)
+	if got, want := failureCounts(w), map[string]int{"a.proto": 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+
+	// The line changed while watching is reported.
+	writeWatchTestFile(t, aPath, watchTestBadProto)
+	w.relint(false)
+	if got, want := failureCounts(w), map[string]int{"a.proto": 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+}
+
+func TestWatcher_reloadConfigIfChanged(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, ".protolint.yaml")
+	w := newTestWatcher(t, dir)
+
+	for _, test := range []struct {
+		name         string
+		inputConfig  string
+		wantChanged  bool
+		wantAdd      []string
+		wantExistErr bool
+	}{
+		{
+			name:        "keep the config unchanged",
+			inputConfig: watchTestConfig,
+			wantAdd:     []string{"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE"},
+		},
+		{
+			name: "reload the changed config",
+			inputConfig: `lint:
+  rules:
+    no_default: true
+    add:
+      - ENUM_FIELD_NAMES_UPPER_SNAKE_CASE
+      - MAX_LINE_LENGTH
+`,
+			wantChanged: true,
+			wantAdd:     []string{"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE", "MAX_LINE_LENGTH"},
+		},
+		{
+			name: "reject the config with an unknown rule ID and keep the last one",
+			inputConfig: `lint:
+  severity_overrides:
+    UNKNOWN_RULE: note
+`,
+			wantAdd:      []string{"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE", "MAX_LINE_LENGTH"},
+			wantExistErr: true,
+		},
+		{
+			name:         "reject the broken config and keep the last one",
+			inputConfig:  "lint: [",
+			wantAdd:      []string{"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE", "MAX_LINE_LENGTH"},
+			wantExistErr: true,
+		},
+	} {
+		if test.inputConfig != watchTestConfig {
+			writeWatchTestFile(t, configPath, test.inputConfig)
+		}
+
+		gotChanged, err := w.reloadConfigIfChanged()
+		if test.wantExistErr {
+			if err == nil {
+				t.Errorf("%s: got err nil, but want err", test.name)
+			}
+		} else if err != nil {
+			t.Errorf("%s: got err %v, but want nil", test.name, err)
+		}
+		if gotChanged != test.wantChanged {
+			t.Errorf("%s: got changed %v, but want %v", test.name, gotChanged, test.wantChanged)
+		}
+		gotAdd := append([]string{}, w.c.config.external.Lint.Rules.Add...)
+		sort.Strings(gotAdd)
+		if !reflect.DeepEqual(gotAdd, test.wantAdd) {
+			t.Errorf("%s: got %v, but want %v", test.name, gotAdd, test.wantAdd)
+		}
+	}
+}
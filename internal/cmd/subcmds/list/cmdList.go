@@ -1,17 +1,16 @@
 package list
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
-
-	"github.com/yoheimuta/protolint/internal/addon/plugin/shared"
+	"strings"
 
 	"github.com/yoheimuta/protolint/internal/linter/config"
 
 	"github.com/yoheimuta/protolint/internal/cmd/subcmds"
 	"github.com/yoheimuta/protolint/internal/osutil"
 	"github.com/yoheimuta/protolint/linter/autodisable"
-	"github.com/yoheimuta/protolint/linter/rule"
 )
 
 // CmdList is a rule list command.
@@ -44,17 +43,45 @@ func (c *CmdList) Run() osutil.ExitCode {
 }
 
 func (c *CmdList) run() error {
-	rules, err := hasIDAndPurposes(c.flags.Plugins)
+	rules, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, false, c.flags.Plugins, false)
 	if err != nil {
 		return err
 	}
+	infos := rules.Infos()
+
+	switch c.flags.Format {
+	case FormatJSON:
+		bs, err := json.MarshalIndent(infos, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(c.stdout, "%s\n", bs)
+		return err
+	case FormatMarkdown:
+		_, err := fmt.Fprint(c.stdout, "# Rules\n")
+		if err != nil {
+			return err
+		}
+		for _, info := range infos {
+			_, err := fmt.Fprintf(c.stdout, "\n%s", info.Markdown())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 
-	for _, r := range rules {
+	for _, info := range infos {
+		var categories string
+		if 0 < len(info.Categories) {
+			categories = " [" + strings.Join(info.Categories, ", ") + "]"
+		}
 		_, err := fmt.Fprintf(
 			c.stdout,
-			"%s: %s\n",
-			r.ID(),
-			r.Purpose(),
+			"%s: %s%s\n",
+			info.ID,
+			info.Purpose,
+			categories,
 		)
 		if err != nil {
 			return err
@@ -62,21 +89,3 @@ func (c *CmdList) run() error {
 	}
 	return nil
 }
-
-type hasIDAndPurpose interface {
-	rule.HasID
-	rule.HasPurpose
-}
-
-func hasIDAndPurposes(plugins []shared.RuleSet) ([]hasIDAndPurpose, error) {
-	rs, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, false, plugins)
-	if err != nil {
-		return nil, err
-	}
-
-	var rules []hasIDAndPurpose
-	for _, r := range rs {
-		rules = append(rules, r)
-	}
-	return rules, nil
-}
@@ -2,6 +2,7 @@ package list
 
 import (
 	"flag"
+	"fmt"
 
 	"github.com/yoheimuta/protolint/internal/cmd/subcmds"
 
@@ -13,8 +14,16 @@ type Flags struct {
 	*flag.FlagSet
 
 	Plugins []shared.RuleSet
+	Format  string
 }
 
+// The list formats.
+const (
+	FormatPlain    = "plain"
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+)
+
 // NewFlags creates a new Flags.
 func NewFlags(
 	args []string,
@@ -27,12 +36,25 @@ func NewFlags(
 	f.Var(
 		&pf,
 		"plugin",
-		`plugins to provide custom lint rule set. Note that it's necessary to specify it as path format'`,
+		`plugins to provide custom lint rule set. Note that it's necessary to specify it as path format'. A path ending with .wasm is loaded as a sandboxed WASI module`,
+	)
+
+	f.StringVar(
+		&f.Format,
+		"format",
+		FormatPlain,
+		"output format. Valid values are plain, json or markdown",
 	)
 
 	_ = f.Parse(args)
 
-	plugins, err := pf.BuildPlugins(false)
+	switch f.Format {
+	case FormatPlain, FormatJSON, FormatMarkdown:
+	default:
+		return Flags{}, fmt.Errorf("%s is an invalid format. valid format is plain, json or markdown", f.Format)
+	}
+
+	plugins, err := pf.BuildPlugins(false, subcmds.DefaultPluginTimeout)
 	if err != nil {
 		return Flags{}, err
 	}
@@ -3,15 +3,24 @@ package subcmds
 import (
 	"fmt"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/yoheimuta/protolint/internal/addon/plugin/shared"
+	"github.com/yoheimuta/protolint/internal/addon/plugin/wasm"
 
 	"github.com/hashicorp/go-plugin"
 )
 
+// wasmExt is the extension of a plugin compiled to a WASI module.
+const wasmExt = ".wasm"
+
+// DefaultPluginTimeout is the default time limit of each call to a plugin.
+const DefaultPluginTimeout = time.Minute
+
 // PluginFlag manages a flag for plugins.
 type PluginFlag struct {
 	raws []string
@@ -29,10 +38,46 @@ func (f *PluginFlag) Set(value string) error {
 }
 
 // BuildPlugins builds all plugins.
-func (f *PluginFlag) BuildPlugins(verbose bool) ([]shared.RuleSet, error) {
+// Each call to a plugin is bounded by the timeout, unless it's zero.
+func (f *PluginFlag) BuildPlugins(
+	verbose bool,
+	timeout time.Duration,
+) ([]shared.RuleSet, error) {
 	var plugins []shared.RuleSet
 
 	for _, value := range f.raws {
+		start := newGRPCPluginStarter(value, verbose)
+		if filepath.Ext(value) == wasmExt {
+			start = newWasmPluginStarter(value, verbose)
+		}
+
+		ruleSet, err := shared.NewSupervisedRuleSet(value, timeout, start)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, ruleSet)
+	}
+	return plugins, nil
+}
+
+func newWasmPluginStarter(
+	value string,
+	verbose bool,
+) shared.StartFunc {
+	return func() (shared.RuleSet, shared.Process, error) {
+		client, err := wasm.NewClient(value, verbose)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed wasm.NewClient(), err=%s", err)
+		}
+		return client, client, nil
+	}
+}
+
+func newGRPCPluginStarter(
+	value string,
+	verbose bool,
+) shared.StartFunc {
+	return func() (shared.RuleSet, shared.Process, error) {
 		level := hclog.Warn
 		if verbose {
 			level = hclog.Trace
@@ -55,14 +100,15 @@ func (f *PluginFlag) BuildPlugins(verbose bool) ([]shared.RuleSet, error) {
 
 		rpcClient, err := client.Client()
 		if err != nil {
-			return nil, fmt.Errorf("failed client.Client(), err=%s", err)
+			client.Kill()
+			return nil, nil, fmt.Errorf("failed client.Client(), err=%s", err)
 		}
 
 		ruleSet, err := rpcClient.Dispense("ruleSet")
 		if err != nil {
-			return nil, fmt.Errorf("failed Dispense, err=%s", err)
+			client.Kill()
+			return nil, nil, fmt.Errorf("failed Dispense, err=%s", err)
 		}
-		plugins = append(plugins, ruleSet.(shared.RuleSet))
+		return ruleSet.(shared.RuleSet), client, nil
 	}
-	return plugins, nil
 }
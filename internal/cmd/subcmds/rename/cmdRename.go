@@ -0,0 +1,62 @@
+package rename
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/yoheimuta/protolint/internal/linter/file"
+	"github.com/yoheimuta/protolint/internal/linter/rename"
+	"github.com/yoheimuta/protolint/internal/osutil"
+)
+
+// CmdRename is a command to rename a message or an enum together with the references to it.
+type CmdRename struct {
+	stdout io.Writer
+	stderr io.Writer
+	flags  Flags
+}
+
+// NewCmdRename creates a new CmdRename.
+func NewCmdRename(
+	flags Flags,
+	stdout io.Writer,
+	stderr io.Writer,
+) *CmdRename {
+	return &CmdRename{
+		flags:  flags,
+		stdout: stdout,
+		stderr: stderr,
+	}
+}
+
+// Run renames the message or the enum in the proto files.
+func (c *CmdRename) Run() osutil.ExitCode {
+	err := c.run()
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	return osutil.ExitSuccess
+}
+
+func (c *CmdRename) run() error {
+	set, err := file.NewProtoSet(c.flags.FilePaths)
+	if err != nil {
+		return err
+	}
+	changes, err := rename.Rename(set.ProtoFiles(), c.flags.FullName, c.flags.NewName)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		_, err = fmt.Fprintf(c.stdout, "%s: %d name(s) renamed\n", change.File.DisplayPath(), change.Edits)
+		if err != nil {
+			return err
+		}
+	}
+	if c.flags.DryRun {
+		return nil
+	}
+	return rename.Apply(changes)
+}
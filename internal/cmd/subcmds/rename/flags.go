@@ -0,0 +1,45 @@
+package rename
+
+import (
+	"flag"
+	"fmt"
+)
+
+// Flags represents a set of rename flag parameters.
+type Flags struct {
+	*flag.FlagSet
+
+	DryRun    bool
+	FullName  string
+	NewName   string
+	FilePaths []string
+}
+
+// NewFlags creates a new Flags.
+func NewFlags(
+	args []string,
+) (Flags, error) {
+	f := Flags{
+		FlagSet: flag.NewFlagSet("rename", flag.ExitOnError),
+	}
+
+	f.BoolVar(
+		&f.DryRun,
+		"dry-run",
+		false,
+		"prints the files to be changed without writing them",
+	)
+
+	_ = f.Parse(args)
+
+	if f.NArg() < 2 {
+		return Flags{}, fmt.Errorf("protolint rename requires the full name and the new name like `protolint rename my.pkg.Foo Bar [paths...]`, but got %v", f.Args())
+	}
+	f.FullName = f.Arg(0)
+	f.NewName = f.Arg(1)
+	f.FilePaths = f.Args()[2:]
+	if len(f.FilePaths) == 0 {
+		f.FilePaths = []string{"."}
+	}
+	return f, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/yoheimuta/protolint/internal/addon/rules"
 	"github.com/yoheimuta/protolint/internal/linter/config"
 	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/internal/stringsutil"
 	"github.com/yoheimuta/protolint/linter/autodisable"
 )
 
@@ -16,10 +17,33 @@ func NewAllRules(
 	autoDisableType autodisable.PlacementType,
 	verbose bool,
 	plugins []shared.RuleSet,
+	pluginErrorAsFailure bool,
+) (internalrule.Rules, error) {
+	return NewAllRulesWithFixOnly(option, fixMode, nil, autoDisableType, verbose, plugins, pluginErrorAsFailure)
+}
+
+// NewAllRulesWithFixOnly creates new all rules like NewAllRules.
+// In fix mode, a non-empty fixOnly limits the rules which fix the problems to the ones with the IDs.
+func NewAllRulesWithFixOnly(
+	option config.RulesOption,
+	fixMode bool,
+	fixOnly []string,
+	autoDisableType autodisable.PlacementType,
+	verbose bool,
+	plugins []shared.RuleSet,
+	pluginErrorAsFailure bool,
 ) (internalrule.Rules, error) {
 	rs := newAllInternalRules(option, fixMode, autoDisableType)
+	if fixMode && 0 < len(fixOnly) {
+		nonFixing := newAllInternalRules(option, false, autoDisableType)
+		for i, r := range rs {
+			if !stringsutil.ContainsStringInSlice(r.ID(), fixOnly) {
+				rs[i] = nonFixing[i]
+			}
+		}
+	}
 
-	es, err := plugin.GetExternalRules(plugins, fixMode, verbose)
+	es, err := plugin.GetExternalRules(plugins, fixMode, fixOnly, verbose, pluginErrorAsFailure)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,171 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/wasm"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/serve/proto"
+	"github.com/yoheimuta/protolint/internal/osutil"
+)
+
+// CmdServe is a command to serve the lint API until it's interrupted.
+type CmdServe struct {
+	stdout io.Writer
+	stderr io.Writer
+	flags  Flags
+}
+
+// NewCmdServe creates a new CmdServe.
+func NewCmdServe(
+	flags Flags,
+	stdout io.Writer,
+	stderr io.Writer,
+) *CmdServe {
+	return &CmdServe{
+		flags:  flags,
+		stdout: stdout,
+		stderr: stderr,
+	}
+}
+
+// Run serves the lint API until it receives SIGINT or SIGTERM.
+func (c *CmdServe) Run() osutil.ExitCode {
+	defer plugin.CleanupClients()
+	defer wasm.CleanupClients()
+
+	err := c.run()
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	return osutil.ExitSuccess
+}
+
+func (c *CmdServe) run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	server := NewServer(c.flags)
+
+	lis, err := listen(c.flags.Listen)
+	if err != nil {
+		return fmt.Errorf("failed to listen at %s, err=%s", c.flags.Listen, err)
+	}
+	grpcServer := grpc.NewServer()
+	proto.RegisterLintServiceServer(grpcServer, server)
+
+	errs := make(chan error, 2)
+	go func() {
+		errs <- grpcServer.Serve(lis)
+	}()
+	_, _ = fmt.Fprintf(c.stderr, "protolint serves the gRPC API at %s\n", c.flags.Listen)
+
+	var httpServer *http.Server
+	if c.flags.HTTPListen != "" {
+		httpLis, err := listen(c.flags.HTTPListen)
+		if err != nil {
+			grpcServer.Stop()
+			return fmt.Errorf("failed to listen at %s, err=%s", c.flags.HTTPListen, err)
+		}
+		httpServer = &http.Server{
+			Handler: NewHTTPHandler(server),
+		}
+		go func() {
+			errs <- httpServer.Serve(httpLis)
+		}()
+		_, _ = fmt.Fprintf(c.stderr, "protolint serves the HTTP API at %s\n", c.flags.HTTPListen)
+	}
+
+	select {
+	case <-ctx.Done():
+		err = nil
+	case err = <-errs:
+	}
+
+	if httpServer != nil {
+		_ = httpServer.Shutdown(context.Background())
+	}
+	grpcServer.GracefulStop()
+	if errors.Is(err, http.ErrServerClosed) || errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+// NewHTTPHandler creates a handler which serves the lint API as JSON over HTTP.
+// It accepts POST /v1/lint and POST /v1/fix, whose bodies are the requests in the JSON mapping of protobuf.
+func NewHTTPHandler(server *Server) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/lint", func(w http.ResponseWriter, r *http.Request) {
+		req := &proto.LintRequest{}
+		serveJSON(w, r, req, func() (protobuf.Message, error) {
+			return server.Lint(r.Context(), req)
+		})
+	})
+	mux.HandleFunc("/v1/fix", func(w http.ResponseWriter, r *http.Request) {
+		req := &proto.FixRequest{}
+		serveJSON(w, r, req, func() (protobuf.Message, error) {
+			return server.Fix(r.Context(), req)
+		})
+	})
+	return mux
+}
+
+func serveJSON(
+	w http.ResponseWriter,
+	r *http.Request,
+	req protobuf.Message,
+	call func() (protobuf.Message, error),
+) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	err = protojson.Unmarshal(body, req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res, err := call()
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), httpStatus(err))
+		return
+	}
+	bs, err := protojson.Marshal(res)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bs)
+}
+
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
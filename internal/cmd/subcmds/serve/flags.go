@@ -0,0 +1,108 @@
+package serve
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/shared"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds"
+)
+
+// defaultSocketName is the name of the socket to serve the gRPC API at when -listen isn't set.
+const defaultSocketName = "protolint.sock"
+
+// Flags represents a set of serve flag parameters.
+type Flags struct {
+	*flag.FlagSet
+
+	Listen               string
+	HTTPListen           string
+	Verbose              bool
+	Plugins              []shared.RuleSet
+	PluginTimeout        time.Duration
+	PluginErrorAsFailure bool
+}
+
+// NewFlags creates a new Flags.
+func NewFlags(
+	args []string,
+) (Flags, error) {
+	f := Flags{
+		FlagSet: flag.NewFlagSet("serve", flag.ExitOnError),
+	}
+	var pf subcmds.PluginFlag
+
+	f.StringVar(
+		&f.Listen,
+		"listen",
+		"",
+		`address to serve the gRPC API at. The format is "unix:///path/to.sock", "tcp://host:port" or "host:port". Defaults to protolint.sock in $XDG_RUNTIME_DIR, or in the user cache directory if it's not set`,
+	)
+	f.StringVar(
+		&f.HTTPListen,
+		"http-listen",
+		"",
+		`address to serve the JSON API over HTTP at, in the same format as -listen. It's disabled by default`,
+	)
+	f.Var(
+		&pf,
+		"plugin",
+		`plugins to provide custom lint rule set. Note that it's necessary to specify it as path format'. A path ending with .wasm is loaded as a sandboxed WASI module`,
+	)
+	f.DurationVar(
+		&f.PluginTimeout,
+		"plugin-timeout",
+		subcmds.DefaultPluginTimeout,
+		"time limit of each call to a plugin. A plugin which exceeds it is killed and restarted on the next call. 0 means no limit",
+	)
+	f.BoolVar(
+		&f.PluginErrorAsFailure,
+		"plugin-error-as-failure",
+		false,
+		"reports a plugin error as a PLUGIN_ERROR lint failure instead of failing the request",
+	)
+	f.BoolVar(
+		&f.Verbose,
+		"v",
+		false,
+		"verbose output that includes the requests",
+	)
+
+	_ = f.Parse(args)
+
+	if f.Listen == "" {
+		listen, err := defaultListen()
+		if err != nil {
+			return Flags{}, fmt.Errorf("failed to decide the default address, err=%s", err)
+		}
+		f.Listen = listen
+	}
+
+	plugins, err := pf.BuildPlugins(f.Verbose, f.PluginTimeout)
+	if err != nil {
+		return Flags{}, err
+	}
+	f.Plugins = plugins
+	return f, nil
+}
+
+// defaultListen returns the socket in the per-user runtime directory,
+// so that the other users can neither connect to it nor take it over.
+func defaultListen() (string, error) {
+	dir := os.Getenv("XDG_RUNTIME_DIR")
+	if dir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(cacheDir, "protolint")
+		err = os.MkdirAll(dir, 0700)
+		if err != nil {
+			return "", err
+		}
+	}
+	return unixScheme + filepath.Join(dir, defaultSocketName), nil
+}
@@ -0,0 +1,40 @@
+package serve_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/serve"
+)
+
+func TestNewFlags(t *testing.T) {
+	runtimeDir := t.TempDir()
+	t.Setenv("XDG_RUNTIME_DIR", runtimeDir)
+
+	for _, test := range []struct {
+		name       string
+		inputArgs  []string
+		wantListen string
+	}{
+		{
+			name:       "serve at the socket in the runtime directory by default",
+			wantListen: "unix://" + filepath.Join(runtimeDir, "protolint.sock"),
+		},
+		{
+			name:       "serve at the address given by -listen",
+			inputArgs:  []string{"-listen", "localhost:50051"},
+			wantListen: "localhost:50051",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := serve.NewFlags(test.inputArgs)
+			if err != nil {
+				t.Fatalf("got err %v", err)
+			}
+			if got.Listen != test.wantListen {
+				t.Errorf("got %s, but want %s", got.Listen, test.wantListen)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package serve
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+const (
+	unixScheme = "unix://"
+	tcpScheme  = "tcp://"
+)
+
+// listen listens at the address, which is "unix:///path/to.sock", "tcp://host:port" or "host:port".
+// A stale unix socket left by a crashed server is removed.
+func listen(address string) (net.Listener, error) {
+	if strings.HasPrefix(address, unixScheme) {
+		path := strings.TrimPrefix(address, unixScheme)
+		err := removeStaleSocket(path)
+		if err != nil {
+			return nil, err
+		}
+		return net.Listen("unix", path)
+	}
+	return net.Listen("tcp", strings.TrimPrefix(address, tcpScheme))
+}
+
+func removeStaleSocket(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s already exists and is not a socket", path)
+	}
+
+	conn, err := net.Dial("unix", path)
+	if err == nil {
+		_ = conn.Close()
+		return fmt.Errorf("another server is already serving at %s", path)
+	}
+	return os.Remove(path)
+}
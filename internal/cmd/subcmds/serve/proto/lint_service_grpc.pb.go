@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.12.4
+// source: lint_service.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LintService_Lint_FullMethodName = "/proto.LintService/Lint"
+	LintService_Fix_FullMethodName  = "/proto.LintService/Fix"
+)
+
+// LintServiceClient is the client API for LintService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LintServiceClient interface {
+	// Lint lints the files and returns the failures.
+	Lint(ctx context.Context, in *LintRequest, opts ...grpc.CallOption) (*LintResponse, error)
+	// Fix fixes the sources and returns their fixed contents. It never rewrites the files on disk.
+	Fix(ctx context.Context, in *FixRequest, opts ...grpc.CallOption) (*FixResponse, error)
+}
+
+type lintServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLintServiceClient(cc grpc.ClientConnInterface) LintServiceClient {
+	return &lintServiceClient{cc}
+}
+
+func (c *lintServiceClient) Lint(ctx context.Context, in *LintRequest, opts ...grpc.CallOption) (*LintResponse, error) {
+	out := new(LintResponse)
+	err := c.cc.Invoke(ctx, LintService_Lint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lintServiceClient) Fix(ctx context.Context, in *FixRequest, opts ...grpc.CallOption) (*FixResponse, error) {
+	out := new(FixResponse)
+	err := c.cc.Invoke(ctx, LintService_Fix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LintServiceServer is the server API for LintService service.
+// All implementations must embed UnimplementedLintServiceServer
+// for forward compatibility
+type LintServiceServer interface {
+	// Lint lints the files and returns the failures.
+	Lint(context.Context, *LintRequest) (*LintResponse, error)
+	// Fix fixes the sources and returns their fixed contents. It never rewrites the files on disk.
+	Fix(context.Context, *FixRequest) (*FixResponse, error)
+	mustEmbedUnimplementedLintServiceServer()
+}
+
+// UnimplementedLintServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLintServiceServer struct {
+}
+
+func (UnimplementedLintServiceServer) Lint(context.Context, *LintRequest) (*LintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lint not implemented")
+}
+func (UnimplementedLintServiceServer) Fix(context.Context, *FixRequest) (*FixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fix not implemented")
+}
+func (UnimplementedLintServiceServer) mustEmbedUnimplementedLintServiceServer() {}
+
+// UnsafeLintServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LintServiceServer will
+// result in compilation errors.
+type UnsafeLintServiceServer interface {
+	mustEmbedUnimplementedLintServiceServer()
+}
+
+func RegisterLintServiceServer(s grpc.ServiceRegistrar, srv LintServiceServer) {
+	s.RegisterService(&LintService_ServiceDesc, srv)
+}
+
+func _LintService_Lint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LintServiceServer).Lint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LintService_Lint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LintServiceServer).Lint(ctx, req.(*LintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LintService_Fix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LintServiceServer).Fix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LintService_Fix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LintServiceServer).Fix(ctx, req.(*FixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LintService_ServiceDesc is the grpc.ServiceDesc for LintService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LintService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.LintService",
+	HandlerType: (*LintServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lint",
+			Handler:    _LintService_Lint_Handler,
+		},
+		{
+			MethodName: "Fix",
+			Handler:    _LintService_Fix_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lint_service.proto",
+}
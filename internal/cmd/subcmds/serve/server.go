@@ -0,0 +1,203 @@
+package serve
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/lint"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/serve/proto"
+	"github.com/yoheimuta/protolint/internal/linter/file"
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	"github.com/yoheimuta/protolint/linter/autodisable"
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+// Server implements the LintService on top of the lint command.
+// The config is loaded on every request, so that a change of it is picked up without a restart.
+type Server struct {
+	proto.UnimplementedLintServiceServer
+
+	flags Flags
+	// mu serializes the requests because a plugin keeps the rules for the fix mode of the last request.
+	mu sync.Mutex
+}
+
+// NewServer creates a new Server.
+func NewServer(flags Flags) *Server {
+	return &Server{
+		flags: flags,
+	}
+}
+
+// Lint lints the files and returns the failures.
+func (s *Server) Lint(
+	_ context.Context,
+	req *proto.LintRequest,
+) (*proto.LintResponse, error) {
+	failures, _, err := s.run(req, false)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.LintResponse{
+		Failures: failures,
+	}, nil
+}
+
+// Fix fixes the sources and returns the failures and the fixed contents of them.
+// The files on disk are left untouched.
+func (s *Server) Fix(
+	_ context.Context,
+	req *proto.FixRequest,
+) (*proto.FixResponse, error) {
+	if len(req.GetSources()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "sources is required")
+	}
+	failures, fixedSources, err := s.run(&proto.LintRequest{
+		Sources:       req.GetSources(),
+		WorkingDir:    req.GetWorkingDir(),
+		ConfigPath:    req.GetConfigPath(),
+		ConfigDirPath: req.GetConfigDirPath(),
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.FixResponse{
+		Failures:     failures,
+		FixedSources: fixedSources,
+	}, nil
+}
+
+func (s *Server) run(
+	req *proto.LintRequest,
+	fixMode bool,
+) ([]*proto.Failure, []*proto.Source, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.flags.Verbose {
+		log.Printf("[INFO] protolint serves a request, fix=%t, files=%v, sources=%d, working_dir=%s\n",
+			fixMode, req.GetFiles(), len(req.GetSources()), req.GetWorkingDir())
+	}
+
+	workDir := req.GetWorkingDir()
+	if workDir != "" {
+		if info, err := os.Stat(workDir); err != nil || !info.IsDir() {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "working_dir %s is not a directory", workDir)
+		}
+	}
+	var protoFiles []file.ProtoFile
+	var sourceFiles []file.ProtoFile
+	sourcePaths := make(map[string]bool)
+	for _, src := range req.GetSources() {
+		f, err := file.NewMemProtoFileFrom(workDir, src.GetPath(), src.GetContent())
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		protoFiles = append(protoFiles, f)
+		sourceFiles = append(sourceFiles, f)
+		sourcePaths[f.Path()] = true
+	}
+	if 0 < len(req.GetFiles()) {
+		set, err := file.NewProtoSetFrom(workDir, req.GetFiles())
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		for _, f := range set.ProtoFiles() {
+			if sourcePaths[f.Path()] {
+				continue
+			}
+			protoFiles = append(protoFiles, f)
+		}
+	}
+	if len(protoFiles) == 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "either files or sources is required")
+	}
+
+	flags := lint.Flags{
+		ConfigPath:           resolvePath(workDir, req.GetConfigPath()),
+		ConfigDirPath:        resolvePath(workDir, req.GetConfigDirPath()),
+		FixMode:              fixMode,
+		Reporter:             reporters.PlainReporter{},
+		AutoDisableType:      autodisable.Noop,
+		Verbose:              s.flags.Verbose,
+		Plugins:              s.flags.Plugins,
+		PluginTimeout:        s.flags.PluginTimeout,
+		PluginErrorAsFailure: s.flags.PluginErrorAsFailure,
+		TimingsFormat:        "text",
+	}
+	cmd, err := lint.NewCmdLintForFiles(flags, protoFiles, workDir, io.Discard, io.Discard)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	failures, err := cmd.Lint()
+	if err != nil {
+		if _, ok := err.(lint.ParseError); ok {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var fixedSources []*proto.Source
+	if fixMode {
+		for i, f := range sourceFiles {
+			content, err := f.Content()
+			if err != nil {
+				return nil, nil, status.Error(codes.Internal, err.Error())
+			}
+			fixedSources = append(fixedSources, &proto.Source{
+				Path:    req.GetSources()[i].GetPath(),
+				Content: content,
+			})
+		}
+	}
+	return toProtoFailures(failures), fixedSources, nil
+}
+
+// resolvePath resolves the relative path against the working directory of the request.
+func resolvePath(workDir string, path string) string {
+	if path == "" || workDir == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(workDir, path)
+}
+
+func toProtoFailures(failures []report.Failure) []*proto.Failure {
+	var ps []*proto.Failure
+	for _, f := range failures {
+		p := &proto.Failure{
+			RuleId:   f.RuleID(),
+			Message:  f.Message(),
+			Severity: f.Severity(),
+			Pos:      toProtoPosition(f.Pos()),
+			Fixed:    f.Fixed(),
+		}
+		if 0 < f.End().Line {
+			p.End = toProtoPosition(f.End())
+		}
+		for _, l := range f.SecondaryLocations() {
+			p.SecondaryLocations = append(p.SecondaryLocations, &proto.Location{
+				Pos:     toProtoPosition(l.Pos),
+				Message: l.Message,
+			})
+		}
+		ps = append(ps, p)
+	}
+	return ps
+}
+
+func toProtoPosition(pos meta.Position) *proto.Position {
+	return &proto.Position{
+		Filename: pos.Filename,
+		Offset:   int32(pos.Offset),
+		Line:     int32(pos.Line),
+		Column:   int32(pos.Column),
+	}
+}
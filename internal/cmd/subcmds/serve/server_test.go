@@ -0,0 +1,222 @@
+package serve_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/serve"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds/serve/proto"
+)
+
+const testConfig = `lint:
+  rules:
+    no_default: true
+    add:
+      - ENUM_FIELD_NAMES_UPPER_SNAKE_CASE
+`
+
+func setUpWorkDir(t *testing.T) string {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		".protolint.yaml": testConfig,
+		"disk.proto":      "syntax = \"proto3\";\nenum Disk {\n  disk_zero = 0;\n}\n",
+	} {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func ruleMessages(failures []*proto.Failure) []string {
+	var got []string
+	for _, f := range failures {
+		got = append(got, f.GetPos().GetFilename()+": "+f.GetMessage())
+	}
+	return got
+}
+
+func TestServer_Lint(t *testing.T) {
+	dir := setUpWorkDir(t)
+
+	for _, test := range []struct {
+		name         string
+		inputRequest *proto.LintRequest
+		wantMessages []string
+		wantCode     codes.Code
+	}{
+		{
+			name: "lint the files on disk",
+			inputRequest: &proto.LintRequest{
+				Files:      []string{"disk.proto"},
+				WorkingDir: dir,
+			},
+			wantMessages: []string{
+				`disk.proto: EnumField name "disk_zero" must be CAPITALS_WITH_UNDERSCORES like "DISK_ZERO"`,
+			},
+		},
+		{
+			name: "lint the sources in place of the files on disk",
+			inputRequest: &proto.LintRequest{
+				Files: []string{"."},
+				Sources: []*proto.Source{
+					{
+						Path:    "disk.proto",
+						Content: []byte("syntax = \"proto3\";\nenum Disk {\n  DISK_ZERO = 0;\n}\n"),
+					},
+					{
+						Path:    "unsaved.proto",
+						Content: []byte("syntax = \"proto3\";\nenum Unsaved {\n  unsaved_zero = 0;\n}\n"),
+					},
+				},
+				WorkingDir: dir,
+			},
+			wantMessages: []string{
+				`unsaved.proto: EnumField name "unsaved_zero" must be CAPITALS_WITH_UNDERSCORES like "UNSAVED_ZERO"`,
+			},
+		},
+		{
+			name: "reject a request without files",
+			inputRequest: &proto.LintRequest{
+				WorkingDir: dir,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "reject a working directory which doesn't exist",
+			inputRequest: &proto.LintRequest{
+				Files:      []string{"disk.proto"},
+				WorkingDir: filepath.Join(dir, "missing"),
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "reject an unparsable source",
+			inputRequest: &proto.LintRequest{
+				Sources: []*proto.Source{
+					{
+						Path:    "broken.proto",
+						Content: []byte("syntax = \"proto3\";\nmessage {\n"),
+					},
+				},
+				WorkingDir: dir,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			server := serve.NewServer(serve.Flags{})
+			got, err := server.Lint(context.Background(), test.inputRequest)
+			if test.wantCode != codes.OK {
+				if status.Code(err) != test.wantCode {
+					t.Errorf("got err %v, but want the code %s", err, test.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got err %v", err)
+			}
+			if !reflect.DeepEqual(ruleMessages(got.GetFailures()), test.wantMessages) {
+				t.Errorf("got %v, but want %v", ruleMessages(got.GetFailures()), test.wantMessages)
+			}
+		})
+	}
+}
+
+func TestServer_Fix(t *testing.T) {
+	dir := setUpWorkDir(t)
+	server := serve.NewServer(serve.Flags{})
+
+	got, err := server.Fix(context.Background(), &proto.FixRequest{
+		Sources: []*proto.Source{
+			{
+				Path:    "unsaved.proto",
+				Content: []byte("syntax = \"proto3\";\nenum Unsaved {\n  unsaved_zero = 0;\n}\n"),
+			},
+		},
+		WorkingDir: dir,
+	})
+	if err != nil {
+		t.Fatalf("got err %v", err)
+	}
+	for _, f := range got.GetFailures() {
+		if !f.GetFixed() {
+			t.Errorf("got the unfixed failure %v, but want all fixed", f)
+		}
+	}
+	if len(got.GetFixedSources()) != 1 {
+		t.Fatalf("got %d fixed sources, but want 1", len(got.GetFixedSources()))
+	}
+	fixed := got.GetFixedSources()[0]
+	if fixed.GetPath() != "unsaved.proto" {
+		t.Errorf("got the path %s, but want unsaved.proto", fixed.GetPath())
+	}
+	want := "syntax = \"proto3\";\nenum Unsaved {\n  UNSAVED_ZERO = 0;\n}\n"
+	if string(fixed.GetContent()) != want {
+		t.Errorf("got %q, but want %q", fixed.GetContent(), want)
+	}
+
+	_, err = os.Stat(filepath.Join(dir, "unsaved.proto"))
+	if !os.IsNotExist(err) {
+		t.Errorf("got the fixed source written to disk, err=%v", err)
+	}
+}
+
+func TestServer_FixWithoutSources(t *testing.T) {
+	dir := setUpWorkDir(t)
+	server := serve.NewServer(serve.Flags{})
+
+	_, err := server.Fix(context.Background(), &proto.FixRequest{
+		WorkingDir: dir,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got err %v, but want the code %s", err, codes.InvalidArgument)
+	}
+}
+
+func TestServer_LintWithEnd(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, ".protolint.yaml"), []byte(`lint:
+  rules:
+    no_default: true
+    add:
+      - MAX_LINE_LENGTH
+  rules_option:
+    max_line_length:
+      max_chars: 20
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := serve.NewServer(serve.Flags{})
+
+	got, err := server.Lint(context.Background(), &proto.LintRequest{
+		Sources: []*proto.Source{
+			{
+				Path:    "long.proto",
+				Content: []byte("syntax = \"proto3\";\nmessage ThisNameIsTooLong {}\n"),
+			},
+		},
+		WorkingDir: dir,
+	})
+	if err != nil {
+		t.Fatalf("got err %v", err)
+	}
+	if len(got.GetFailures()) != 1 {
+		t.Fatalf("got %v, but want a failure", got.GetFailures())
+	}
+	end := got.GetFailures()[0].GetEnd()
+	want := &proto.Position{Filename: "long.proto", Offset: 47, Line: 2, Column: 29}
+	if end.GetFilename() != want.GetFilename() || end.GetOffset() != want.GetOffset() ||
+		end.GetLine() != want.GetLine() || end.GetColumn() != want.GetColumn() {
+		t.Errorf("got the end %v, but want %v", end, want)
+	}
+}
@@ -0,0 +1,170 @@
+package gitdiff
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// LineRange represents the lines from Start to End. Both are inclusive and 1-based.
+type LineRange struct {
+	Start int
+	End   int
+}
+
+// Changes represents the changed lines keyed by the absolute path of each file.
+// A nil slice of ranges means that the whole file is changed.
+type Changes map[string][]LineRange
+
+func (c Changes) addWholeFile(absPath string) {
+	c[evalSymlinks(absPath)] = nil
+}
+
+// addLine adds the line, extending the last range if it's adjacent.
+// The absPath must be evaluated by evalSymlinks.
+func (c Changes) addLine(
+	absPath string,
+	line int,
+) {
+	ranges := c[absPath]
+	if last := len(ranges) - 1; 0 <= last && ranges[last].End+1 == line {
+		ranges[last].End = line
+		return
+	}
+	c[absPath] = append(ranges, LineRange{
+		Start: line,
+		End:   line,
+	})
+}
+
+// HasFile checks whether or not the file is changed.
+func (c Changes) HasFile(absPath string) bool {
+	_, ok := c[evalSymlinks(absPath)]
+	return ok
+}
+
+// Contains checks whether or not the line of the file is changed.
+func (c Changes) Contains(
+	absPath string,
+	line int,
+) bool {
+	ranges, ok := c[evalSymlinks(absPath)]
+	if !ok {
+		return false
+	}
+	if ranges == nil {
+		return true
+	}
+	for _, r := range ranges {
+		if r.Start <= line && line <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+var hunkHeader = regexp.MustCompile(`^@@ -\d+(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// ParsePatch parses the unified diff, and returns the added lines of the new files.
+// The context lines around them aren't regarded as changed.
+// The paths in the diff are resolved against rootDir. The "b/" prefix added by git is trimmed, and the quoted paths are unquoted.
+func ParsePatch(
+	r io.Reader,
+	rootDir string,
+) (Changes, error) {
+	changes := make(Changes)
+
+	var current string
+	// newLine is the line number in the new file of the next line in the hunk.
+	// oldLeft and newLeft are the numbers of the lines left in the hunk.
+	var newLine, oldLeft, newLeft int
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if oldLeft <= 0 && newLeft <= 0 {
+			switch {
+			case strings.HasPrefix(line, "+++ "):
+				current = newFilePath(strings.TrimPrefix(line, "+++ "), rootDir)
+			case strings.HasPrefix(line, "@@ "):
+				m := hunkHeader.FindStringSubmatch(line)
+				if m == nil {
+					return nil, fmt.Errorf("invalid hunk header %q", line)
+				}
+				oldLeft = hunkCount(m[1])
+				newLine, _ = strconv.Atoi(m[2])
+				newLeft = hunkCount(m[3])
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "+"):
+			if current != "" {
+				changes.addLine(current, newLine)
+			}
+			newLine++
+			newLeft--
+		case strings.HasPrefix(line, "-"):
+			oldLeft--
+		case strings.HasPrefix(line, `\`):
+			// "\ No newline at end of file"
+		default:
+			newLine++
+			oldLeft--
+			newLeft--
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// hunkCount returns the number of the lines in the hunk header, which is 1 if omitted.
+func hunkCount(s string) int {
+	if s == "" {
+		return 1
+	}
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// newFilePath returns the absolute path of the new file in the "+++" line.
+// It returns an empty string for a deleted file.
+func newFilePath(
+	name string,
+	rootDir string,
+) string {
+	// diff -u appends a timestamp after a tab.
+	if i := strings.Index(name, "\t"); 0 <= i {
+		name = name[:i]
+	}
+	if name == "/dev/null" {
+		return ""
+	}
+	// git quotes a path which has unusual characters like a double quote or non-ASCII ones in the C style.
+	if strings.HasPrefix(name, `"`) {
+		if unquoted, err := strconv.Unquote(name); err == nil {
+			name = unquoted
+		}
+	}
+	name = strings.TrimPrefix(name, "b/")
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(rootDir, filepath.FromSlash(name))
+	}
+	return evalSymlinks(filepath.Clean(name))
+}
+
+// evalSymlinks returns the path after evaluating any symbolic links if possible,
+// so that the paths reported by git and the ones given by the user can be compared.
+func evalSymlinks(path string) string {
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		return p
+	}
+	return path
+}
@@ -0,0 +1,163 @@
+package gitdiff_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/gitdiff"
+)
+
+func TestParsePatch(t *testing.T) {
+	rootDir := filepath.Join(t.TempDir(), "root")
+
+	for _, test := range []struct {
+		name        string
+		inputPatch  string
+		wantChanges gitdiff.Changes
+	}{
+		{
+			name: "a patch made by git",
+			inputPatch: `diff --git a/proto/a.proto b/proto/a.proto
+index 1111111..2222222 100644
+--- a/proto/a.proto
++++ b/proto/a.proto
+@@ -3,3 +3,6 @@ package foo;
+ message A {
+   string aa = 1;
+ }
++message B {
++  string Bb = 1;
++}
+@@ -10 +13 @@ message C {
+-  string c = 1;
++  string Cc = 1;
+diff --git a/proto/deleted.proto b/proto/deleted.proto
+deleted file mode 100644
+--- a/proto/deleted.proto
++++ /dev/null
+@@ -1 +0,0 @@
+-syntax = "proto3";
+`,
+			wantChanges: gitdiff.Changes{
+				filepath.Join(rootDir, "proto", "a.proto"): {
+					{Start: 6, End: 8},
+					{Start: 13, End: 13},
+				},
+			},
+		},
+		{
+			name: "a patch made by diff -u without separators between files",
+			inputPatch: `--- a.proto	2024-01-01 00:00:00.000000000 +0900
++++ a.proto	2024-01-02 00:00:00.000000000 +0900
+@@ -1,2 +1,2 @@
+ syntax = "proto3";
+-package foo;
++package Foo;
+--- b.proto	2024-01-01 00:00:00.000000000 +0900
++++ b.proto	2024-01-02 00:00:00.000000000 +0900
+@@ -1 +1,2 @@
+ syntax = "proto3";
++package bar;
+\ No newline at end of file
+`,
+			wantChanges: gitdiff.Changes{
+				filepath.Join(rootDir, "a.proto"): {
+					{Start: 2, End: 2},
+				},
+				filepath.Join(rootDir, "b.proto"): {
+					{Start: 2, End: 2},
+				},
+			},
+		},
+		{
+			name: "a patch made by git with the paths which have a space and non-ASCII characters",
+			inputPatch: "diff --git a/a b.proto b/a b.proto\n" +
+				"--- a/a b.proto\t\n" +
+				"+++ b/a b.proto\t\n" +
+				"@@ -1,0 +2 @@\n" +
+				"+package foo;\n" +
+				"diff --git \"a/\\303\\274.proto\" \"b/\\303\\274.proto\"\n" +
+				"--- \"a/\\303\\274.proto\"\n" +
+				"+++ \"b/\\303\\274.proto\"\n" +
+				"@@ -1,0 +2 @@\n" +
+				"+package bar;\n",
+			wantChanges: gitdiff.Changes{
+				filepath.Join(rootDir, "a b.proto"): {
+					{Start: 2, End: 2},
+				},
+				filepath.Join(rootDir, "\u00fc.proto"): {
+					{Start: 2, End: 2},
+				},
+			},
+		},
+		{
+			name: "a hunk which only deletes lines",
+			inputPatch: `--- a/a.proto
++++ b/a.proto
+@@ -2 +1,0 @@
+-package foo;
+`,
+			wantChanges: gitdiff.Changes{},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := gitdiff.ParsePatch(strings.NewReader(test.inputPatch), rootDir)
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			if !reflect.DeepEqual(got, test.wantChanges) {
+				t.Errorf("got %v, but want %v", got, test.wantChanges)
+			}
+		})
+	}
+}
+
+func TestChanges_Contains(t *testing.T) {
+	changes := gitdiff.Changes{
+		"/a.proto": {
+			{Start: 3, End: 5},
+		},
+		"/whole.proto": nil,
+	}
+
+	for _, test := range []struct {
+		name      string
+		inputPath string
+		inputLine int
+		want      bool
+	}{
+		{
+			name:      "in the range",
+			inputPath: "/a.proto",
+			inputLine: 5,
+			want:      true,
+		},
+		{
+			name:      "out of the range",
+			inputPath: "/a.proto",
+			inputLine: 6,
+		},
+		{
+			name:      "the whole file is changed",
+			inputPath: "/whole.proto",
+			inputLine: 100,
+			want:      true,
+		},
+		{
+			name:      "not changed file",
+			inputPath: "/b.proto",
+			inputLine: 1,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := changes.Contains(test.inputPath, test.inputLine)
+			if got != test.want {
+				t.Errorf("got %v, but want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package gitdiff
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// git runs the git command in the working directory and returns its stdout.
+func git(args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed git %s, err=%s, stderr=%s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// topLevel returns the absolute path of the top-level directory of the repository.
+func topLevel() (string, error) {
+	out, err := git("rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", err
+	}
+	return filepath.FromSlash(strings.TrimSpace(string(out))), nil
+}
+
+// isCommit checks whether or not git resolves the ref to a commit.
+func isCommit(ref string) bool {
+	// Don't let git take the ref for an option.
+	if strings.HasPrefix(ref, "-") {
+		return false
+	}
+	_, err := git("rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	return err == nil
+}
+
+// splitNull splits the NUL-separated output of git into the absolute paths.
+func splitNull(
+	rootDir string,
+	out []byte,
+) []string {
+	var paths []string
+	for _, p := range strings.Split(string(out), "\x00") {
+		if p == "" {
+			continue
+		}
+		paths = append(paths, filepath.Join(rootDir, filepath.FromSlash(p)))
+	}
+	return paths
+}
+
+// untrackedFiles returns the absolute paths of the files which aren't tracked yet and aren't ignored.
+func untrackedFiles(rootDir string) ([]string, error) {
+	out, err := git("ls-files", "--others", "--exclude-standard", "--full-name", "-z", "--", rootDir)
+	if err != nil {
+		return nil, err
+	}
+	return splitNull(rootDir, out), nil
+}
+
+// NewChangedFiles returns the files changed in the working tree since the ref as a whole,
+// including the untracked files. The deleted files are excluded.
+func NewChangedFiles(ref string) (Changes, error) {
+	rootDir, err := topLevel()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := git("diff", "--name-only", "--diff-filter=d", "-z", ref, "--")
+	if err != nil {
+		return nil, err
+	}
+	paths := splitNull(rootDir, out)
+
+	untracked, err := untrackedFiles(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(Changes)
+	for _, path := range append(paths, untracked...) {
+		changes.addWholeFile(path)
+	}
+	return changes, nil
+}
+
+// NewChangedLines returns the lines changed in the working tree.
+// refOrPatch is either a ref to compare the working tree with, or a path to a unified diff file.
+// It's regarded as a ref if git resolves it to a commit, even if a file with the same name exists.
+// In the former case, all lines of the untracked files are regarded as changed.
+func NewChangedLines(refOrPatch string) (Changes, error) {
+	if !isCommit(refOrPatch) {
+		if info, err := os.Stat(refOrPatch); err == nil && info.Mode().IsRegular() {
+			return newChangedLinesFromPatchFile(refOrPatch)
+		}
+		return nil, fmt.Errorf("%s is neither a git ref nor a patch file", refOrPatch)
+	}
+
+	rootDir, err := topLevel()
+	if err != nil {
+		return nil, err
+	}
+	// Fix the prefixes regardless of diff.noprefix and diff.mnemonicPrefix, because ParsePatch trims "b/".
+	out, err := git("diff", "--no-color", "--no-ext-diff", "--src-prefix=a/", "--dst-prefix=b/", "-U0", refOrPatch, "--")
+	if err != nil {
+		return nil, err
+	}
+	changes, err := ParsePatch(bytes.NewReader(out), rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	untracked, err := untrackedFiles(rootDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range untracked {
+		changes.addWholeFile(path)
+	}
+	return changes, nil
+}
+
+func newChangedLinesFromPatchFile(path string) (Changes, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	// A patch made by git has the paths relative to the top-level directory.
+	rootDir, err := topLevel()
+	if err != nil {
+		rootDir, err = os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ParsePatch(f, rootDir)
+}
@@ -0,0 +1,208 @@
+package gitdiff_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/gitdiff"
+)
+
+// setUpRepo creates a repository with a commit, and changes the working directory to it.
+// The working tree has a modified file, an untracked file, and a deleted file since the commit.
+func setUpRepo(t *testing.T) string {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("skip because git isn't installed")
+	}
+
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	runGit := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("failed git %v, err=%v, out=%s", args, err, out)
+		}
+	}
+	writeFile := func(name string, content string) {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runGit("init", "-q")
+	writeFile("modified.proto", "syntax = \"proto3\";\nmessage A {}\n")
+	writeFile("deleted.proto", "syntax = \"proto3\";\n")
+	writeFile("unchanged.proto", "syntax = \"proto3\";\n")
+	runGit("add", ".")
+	runGit("commit", "-q", "-m", "initial")
+
+	writeFile("modified.proto", "syntax = \"proto3\";\npackage foo;\nmessage A {}\n")
+	writeFile("untracked.proto", "syntax = \"proto3\";\n")
+	err = os.Remove(filepath.Join(dir, "deleted.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Chdir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = os.Chdir(cwd)
+	})
+	return dir
+}
+
+func TestNewChangedFiles(t *testing.T) {
+	dir := setUpRepo(t)
+
+	got, err := gitdiff.NewChangedFiles("HEAD")
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	want := gitdiff.Changes{
+		filepath.Join(dir, "modified.proto"):  nil,
+		filepath.Join(dir, "untracked.proto"): nil,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+
+	_, err = gitdiff.NewChangedFiles("unknown-ref")
+	if err == nil {
+		t.Errorf("got err nil, but want err")
+	}
+}
+
+func TestNewChangedLines(t *testing.T) {
+	dir := setUpRepo(t)
+	patch := `--- a/unchanged.proto
++++ b/unchanged.proto
+@@ -1,0 +2 @@
++package bar;
+`
+
+	for _, test := range []struct {
+		name            string
+		inputRefOrPatch string
+		// inputFile is the file created in the working tree before the call.
+		inputFile string
+		// inputGitConfig is the git config set in the repository before the call.
+		inputGitConfig []string
+		wantChanges    gitdiff.Changes
+		wantExistErr   bool
+	}{
+		{
+			name:            "compare with the ref",
+			inputRefOrPatch: "HEAD",
+			wantChanges: gitdiff.Changes{
+				filepath.Join(dir, "modified.proto"): {
+					{Start: 2, End: 2},
+				},
+				filepath.Join(dir, "untracked.proto"): nil,
+			},
+		},
+		{
+			name:            "compare with the ref with diff.mnemonicPrefix",
+			inputRefOrPatch: "HEAD",
+			inputGitConfig:  []string{"diff.mnemonicPrefix", "true"},
+			wantChanges: gitdiff.Changes{
+				filepath.Join(dir, "modified.proto"): {
+					{Start: 2, End: 2},
+				},
+				filepath.Join(dir, "untracked.proto"): nil,
+			},
+		},
+		{
+			name:            "compare with the ref with diff.noprefix",
+			inputRefOrPatch: "HEAD",
+			inputGitConfig:  []string{"diff.noprefix", "true"},
+			wantChanges: gitdiff.Changes{
+				filepath.Join(dir, "modified.proto"): {
+					{Start: 2, End: 2},
+				},
+				filepath.Join(dir, "untracked.proto"): nil,
+			},
+		},
+		{
+			name:            "read the patch file",
+			inputRefOrPatch: "change.patch",
+			inputFile:       "change.patch",
+			wantChanges: gitdiff.Changes{
+				filepath.Join(dir, "unchanged.proto"): {
+					{Start: 2, End: 2},
+				},
+			},
+		},
+		{
+			name:            "prefer the ref to the file with the same name",
+			inputRefOrPatch: "HEAD",
+			inputFile:       "HEAD",
+			wantChanges: gitdiff.Changes{
+				filepath.Join(dir, "modified.proto"): {
+					{Start: 2, End: 2},
+				},
+				filepath.Join(dir, "untracked.proto"): nil,
+				filepath.Join(dir, "HEAD"):            nil,
+			},
+		},
+		{
+			name:            "neither a ref nor a file",
+			inputRefOrPatch: "unknown-ref",
+			wantExistErr:    true,
+		},
+		{
+			name:            "not take the ref for an option",
+			inputRefOrPatch: "--output=out",
+			wantExistErr:    true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			if test.inputFile != "" {
+				path := filepath.Join(dir, test.inputFile)
+				err := os.WriteFile(path, []byte(patch), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer func() {
+					_ = os.Remove(path)
+				}()
+			}
+
+			if test.inputGitConfig != nil {
+				out, err := exec.Command("git", append([]string{"config"}, test.inputGitConfig...)...).CombinedOutput()
+				if err != nil {
+					t.Fatalf("failed git config, err=%v, out=%s", err, out)
+				}
+				defer func() {
+					_ = exec.Command("git", "config", "--unset", test.inputGitConfig[0]).Run()
+				}()
+			}
+
+			got, err := gitdiff.NewChangedLines(test.inputRefOrPatch)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			if !reflect.DeepEqual(got, test.wantChanges) {
+				t.Errorf("got %v, but want %v", got, test.wantChanges)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+// DefaultLocation is the default directory of the cache.
+const DefaultLocation = ".protolint-cache"
+
+// formatVersion is changed whenever the format of the entries changes.
+const formatVersion = "2"
+
+// Key identifies an entry of the cache.
+type Key string
+
+// Salt is the part of the key which is common to all files in a run,
+// like the protolint version, the resolved config and the plugin identities.
+type Salt string
+
+// NewSalt creates a new Salt from the parts.
+func NewSalt(parts ...string) Salt {
+	h := sha256.New()
+	for _, p := range append([]string{formatVersion}, parts...) {
+		_, _ = h.Write([]byte(p))
+		_, _ = h.Write([]byte{0})
+	}
+	return Salt(hex.EncodeToString(h.Sum(nil)))
+}
+
+// NewKey creates a new Key of the file with the content.
+// The displayPath is a part of the key because the failures and the config depend on it.
+func (s Salt) NewKey(
+	displayPath string,
+	content []byte,
+) Key {
+	h := sha256.New()
+	for _, p := range [][]byte{[]byte(s), []byte(displayPath), content} {
+		_, _ = h.Write(p)
+		_, _ = h.Write([]byte{0})
+	}
+	return Key(hex.EncodeToString(h.Sum(nil)))
+}
+
+// Cache is an on-disk cache of the failures, keyed by Key.
+type Cache struct {
+	dir string
+}
+
+// NewCache creates a new Cache. The dir is created on the first Put.
+func NewCache(dir string) *Cache {
+	return &Cache{
+		dir: dir,
+	}
+}
+
+type entryPosition struct {
+	Filename string `json:"filename"`
+	Offset   int    `json:"offset"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+}
+
+func newEntryPosition(pos meta.Position) entryPosition {
+	return entryPosition{
+		Filename: pos.Filename,
+		Offset:   pos.Offset,
+		Line:     pos.Line,
+		Column:   pos.Column,
+	}
+}
+
+func (p entryPosition) position() meta.Position {
+	return meta.Position{
+		Filename: p.Filename,
+		Offset:   p.Offset,
+		Line:     p.Line,
+		Column:   p.Column,
+	}
+}
+
+type entryLocation struct {
+	Pos     entryPosition `json:"pos"`
+	Message string        `json:"message"`
+}
+
+type entryFailure struct {
+	Pos                entryPosition   `json:"pos"`
+	End                entryPosition   `json:"end"`
+	RuleID             string          `json:"rule_id"`
+	Severity           string          `json:"severity"`
+	Message            string          `json:"message"`
+	SecondaryLocations []entryLocation `json:"secondary_locations,omitempty"`
+}
+
+func (c *Cache) path(key Key) string {
+	// Spread the entries into subdirectories to keep each directory small.
+	return filepath.Join(c.dir, string(key[:2]), string(key)+".json")
+}
+
+// Get returns the cached failures. It returns false when the entry is missing or broken.
+func (c *Cache) Get(key Key) ([]report.Failure, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var entries []entryFailure
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, false
+	}
+
+	failures := make([]report.Failure, 0, len(entries))
+	for _, e := range entries {
+		var locations []report.Location
+		for _, l := range e.SecondaryLocations {
+			locations = append(locations, report.Location{
+				Pos:     l.Pos.position(),
+				Message: l.Message,
+			})
+		}
+		failures = append(failures, report.FailureWithSeverityf(
+			e.Pos.position(),
+			e.RuleID,
+			e.Severity,
+			"%s",
+			e.Message,
+		).WithEnd(e.End.position()).WithSecondaryLocations(locations...))
+	}
+	return failures, true
+}
+
+// Put stores the failures.
+// It writes the entry to a temporary file and renames it, so that a concurrent Get never sees a partial entry.
+func (c *Cache) Put(
+	key Key,
+	failures []report.Failure,
+) error {
+	entries := make([]entryFailure, 0, len(failures))
+	for _, f := range failures {
+		var locations []entryLocation
+		for _, l := range f.SecondaryLocations() {
+			locations = append(locations, entryLocation{
+				Pos:     newEntryPosition(l.Pos),
+				Message: l.Message,
+			})
+		}
+		entries = append(entries, entryFailure{
+			Pos:                newEntryPosition(f.Pos()),
+			End:                newEntryPosition(f.End()),
+			RuleID:             f.RuleID(),
+			Severity:           f.Severity(),
+			Message:            f.Message(),
+			SecondaryLocations: locations,
+		})
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	path := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,108 @@
+package cache_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/internal/linter/cache"
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+func TestCache_PutAndGet(t *testing.T) {
+	c := cache.NewCache(t.TempDir())
+	key := cache.NewSalt("v1", "config").NewKey("a.proto", []byte("syntax = \"proto3\";"))
+
+	_, ok := c.Get(key)
+	if ok {
+		t.Fatalf("got a hit, but want a miss before Put")
+	}
+
+	for _, test := range []struct {
+		name          string
+		inputFailures []report.Failure
+	}{
+		{
+			name: "failures",
+			inputFailures: []report.Failure{
+				report.FailureWithSeverityf(
+					meta.Position{Filename: "a.proto", Offset: 10, Line: 2, Column: 3},
+					"FIELD_NAMES_LOWER_SNAKE_CASE",
+					"warning",
+					"Field name %q must be underscore_separated_names",
+					"SongName",
+				),
+				report.Failuref(
+					meta.Position{Filename: "a.proto", Line: 5, Column: 1},
+					"MAX_LINE_LENGTH",
+					"The line length is %d",
+					100,
+				),
+			},
+		},
+		{
+			name:          "no failures",
+			inputFailures: []report.Failure{},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := c.Put(key, test.inputFailures)
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			got, ok := c.Get(key)
+			if !ok {
+				t.Fatalf("got a miss, but want a hit")
+			}
+			if !reflect.DeepEqual(got, test.inputFailures) {
+				t.Errorf("got %v, but want %v", got, test.inputFailures)
+			}
+		})
+	}
+}
+
+func TestSalt_NewKey(t *testing.T) {
+	salt := cache.NewSalt("v1", "config")
+	key := salt.NewKey("a.proto", []byte("content"))
+
+	for _, test := range []struct {
+		name       string
+		inputKey   cache.Key
+		wantSameAs bool
+	}{
+		{
+			name:       "same inputs",
+			inputKey:   cache.NewSalt("v1", "config").NewKey("a.proto", []byte("content")),
+			wantSameAs: true,
+		},
+		{
+			name:     "different version",
+			inputKey: cache.NewSalt("v2", "config").NewKey("a.proto", []byte("content")),
+		},
+		{
+			name:     "different config",
+			inputKey: cache.NewSalt("v1", "config2").NewKey("a.proto", []byte("content")),
+		},
+		{
+			name:     "ambiguous boundary between parts",
+			inputKey: cache.NewSalt("v1c", "onfig").NewKey("a.proto", []byte("content")),
+		},
+		{
+			name:     "different path",
+			inputKey: salt.NewKey("b.proto", []byte("content")),
+		},
+		{
+			name:     "different content",
+			inputKey: salt.NewKey("a.proto", []byte("content2")),
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.inputKey == key; got != test.wantSameAs {
+				t.Errorf("got same %v, but want %v", got, test.wantSameAs)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package config
 
+import "github.com/yoheimuta/protolint/linter/rule"
+
 // Lint represents the lint configuration.
 type Lint struct {
 	Ignores     Ignores
@@ -7,6 +9,14 @@ type Lint struct {
 	Directories Directories
 	Rules       Rules
 	RulesOption RulesOption `yaml:"rules_option" json:"rules_option" toml:"rules_option"`
+	// SeverityOverrides maps a rule ID to the severity, which takes precedence over the default one.
+	SeverityOverrides map[string]rule.Severity `yaml:"severity_overrides" json:"severity_overrides" toml:"severity_overrides"`
+	// CategorySeverities maps a category to the severity of the rules in it. SeverityOverrides takes precedence over it.
+	CategorySeverities map[string]rule.Severity `yaml:"category_severities" json:"category_severities" toml:"category_severities"`
+	// ReporterTemplate is used by the template reporter unless the flag specifies the template.
+	ReporterTemplate ReporterTemplate `yaml:"reporter_template" json:"reporter_template" toml:"reporter_template"`
+	// MarkdownReporter configures the markdown reporter.
+	MarkdownReporter MarkdownReporterOption `yaml:"markdown_reporter" json:"markdown_reporter" toml:"markdown_reporter"`
 }
 
 // ExternalConfig represents the external configuration.
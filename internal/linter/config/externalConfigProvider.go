@@ -30,7 +30,18 @@ func GetExternalConfig(
 	filePath string,
 	dirPath string,
 ) (*ExternalConfig, error) {
-	reader, err := getExternalConfigLoader(filePath, dirPath)
+	return GetExternalConfigFrom(filePath, dirPath, "")
+}
+
+// GetExternalConfigFrom provides the externalConfig like GetExternalConfig.
+// When neither filePath nor dirPath is set, it searches searchDirPath and its parent directories
+// instead of the working directory. An empty searchDirPath means the working directory.
+func GetExternalConfigFrom(
+	filePath string,
+	dirPath string,
+	searchDirPath string,
+) (*ExternalConfig, error) {
+	reader, err := getExternalConfigLoader(filePath, dirPath, searchDirPath)
 	if err != nil {
 		if len(filePath) == 0 && len(dirPath) == 0 {
 			return nil, nil
@@ -62,6 +73,7 @@ func getLoaderFromExtension(filePath string) (configLoader, error) {
 func getExternalConfigLoader(
 	filePath string,
 	dirPath string,
+	searchDirPath string,
 ) (configLoader, error) {
 	if 0 < len(filePath) {
 		return getLoaderFromExtension(filePath)
@@ -69,7 +81,8 @@ func getExternalConfigLoader(
 
 	dirPaths := []string{dirPath}
 	if len(dirPath) == 0 {
-		absPath, err := os.Getwd()
+		dirPaths = []string{searchDirPath}
+		absPath, err := filepath.Abs(searchDirPath)
 		if err != nil {
 			return nil, err
 		}
@@ -48,12 +48,7 @@ func TestGetExternalConfig(t *testing.T) {
 							},
 						},
 					},
-					Rules: struct {
-						NoDefault  bool     `yaml:"no_default" json:"no_default" toml:"no_default"`
-						AllDefault bool     `yaml:"all_default" json:"all_default" toml:"all_default"`
-						Add        []string `yaml:"add" json:"add" toml:"add"`
-						Remove     []string `yaml:"remove" json:"remove" toml:"remove"`
-					}{
+					Rules: config.Rules{
 						NoDefault: true,
 						Add: []string{
 							"FIELD_NAMES_LOWER_SNAKE_CASE",
@@ -355,3 +350,60 @@ func TestGetExternalConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGetExternalConfigFrom(t *testing.T) {
+	defaultConfig := config.Lint{
+		RulesOption: config.RulesOption{
+			Indent: config.IndentOption{
+				Style:   "\t",
+				Newline: "\n",
+			},
+		},
+	}
+
+	for _, test := range []struct {
+		name               string
+		inputDirPath       string
+		inputSearchDirPath string
+		wantExternalConfig *config.ExternalConfig
+	}{
+		{
+			name:               "load .protolint.yml at the search dir",
+			inputSearchDirPath: setting_test.TestDataPath("validconfig", "default"),
+			wantExternalConfig: &config.ExternalConfig{
+				SourcePath: setting_test.TestDataPath("validconfig", "default", ".protolint.yml"),
+				Lint:       defaultConfig,
+			},
+		},
+		{
+			name:               "locate .protolint.yml at the grand parent when not found at the search dir",
+			inputSearchDirPath: setting_test.TestDataPath("validconfig", "default", "empty_child", "empty_grand_child"),
+			wantExternalConfig: &config.ExternalConfig{
+				SourcePath: setting_test.TestDataPath("validconfig", "default", ".protolint.yml"),
+				Lint:       defaultConfig,
+			},
+		},
+		{
+			name:               "prefer inputDirPath to the search dir",
+			inputDirPath:       setting_test.TestDataPath("validconfig", "default"),
+			inputSearchDirPath: setting_test.TestDataPath("validconfig", "default", "child"),
+			wantExternalConfig: &config.ExternalConfig{
+				SourcePath: setting_test.TestDataPath("validconfig", "default", ".protolint.yml"),
+				Lint:       defaultConfig,
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := config.GetExternalConfigFrom("", test.inputDirPath, test.inputSearchDirPath)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+
+			if !reflect.DeepEqual(got, test.wantExternalConfig) {
+				t.Errorf("got %v, but want %v", got, test.wantExternalConfig)
+			}
+		})
+	}
+}
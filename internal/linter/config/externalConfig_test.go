@@ -43,12 +43,7 @@ func TestExternalConfig_ShouldSkipRule(t *testing.T) {
 					`path\to\file_windows.proto`,
 				},
 			},
-			Rules: struct {
-				NoDefault  bool     `yaml:"no_default" json:"no_default" toml:"no_default"`
-				AllDefault bool     `yaml:"all_default" json:"all_default" toml:"all_default"`
-				Add        []string `yaml:"add" json:"add" toml:"add"`
-				Remove     []string `yaml:"remove" json:"remove" toml:"remove"`
-			}{
+			Rules: config.Rules{
 				NoDefault: true,
 				Add: []string{
 					"FIELD_NAMES_LOWER_SNAKE_CASE",
@@ -80,12 +75,7 @@ func TestExternalConfig_ShouldSkipRule(t *testing.T) {
 					},
 				},
 			},
-			Rules: struct {
-				NoDefault  bool     `yaml:"no_default" json:"no_default" toml:"no_default"`
-				AllDefault bool     `yaml:"all_default" json:"all_default" toml:"all_default"`
-				Add        []string `yaml:"add" json:"add" toml:"add"`
-				Remove     []string `yaml:"remove" json:"remove" toml:"remove"`
-			}{
+			Rules: config.Rules{
 				NoDefault: false,
 				Add: []string{
 					"FIELD_NAMES_LOWER_SNAKE_CASE",
@@ -98,7 +88,7 @@ func TestExternalConfig_ShouldSkipRule(t *testing.T) {
 		},
 	}
 
-	allRules, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, false, nil)
+	allRules, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, false, nil, false)
 	if err != nil {
 		t.Error(err)
 		return
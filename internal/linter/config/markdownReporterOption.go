@@ -0,0 +1,11 @@
+package config
+
+// MarkdownReporterOption represents the option for the markdown reporter.
+type MarkdownReporterOption struct {
+	// BaseURL links the findings to the lines, like https://github.com/OWNER/REPO/blob/SHA.
+	BaseURL string `yaml:"base_url" json:"base_url" toml:"base_url"`
+	// MaxFindings is the number of the findings shown at most. 0 means the default.
+	MaxFindings int `yaml:"max_findings" json:"max_findings" toml:"max_findings"`
+	// MaxBytes is the size of the output at most. 0 means the default, which fits in a pull-request comment.
+	MaxBytes int `yaml:"max_bytes" json:"max_bytes" toml:"max_bytes"`
+}
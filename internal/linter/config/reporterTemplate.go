@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ReporterTemplate represents the template of the template reporter.
+// File is relative to the config file. Inline is the template itself, which takes precedence over File.
+type ReporterTemplate struct {
+	File   string `yaml:"file" json:"file" toml:"file"`
+	Inline string `yaml:"inline" json:"inline" toml:"inline"`
+}
+
+// Text returns the template. It returns an empty string if neither is set.
+func (t ReporterTemplate) Text(configPath string) (string, error) {
+	if t.Inline != "" {
+		return t.Inline, nil
+	}
+	if t.File == "" {
+		return "", nil
+	}
+	path := t.File
+	if !filepath.IsAbs(path) && configPath != "" {
+		path = filepath.Join(filepath.Dir(configPath), path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the reporter template, err=%s", err)
+	}
+	return string(content), nil
+}
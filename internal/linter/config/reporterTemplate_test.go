@@ -0,0 +1,81 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/linter/config"
+)
+
+func TestReporterTemplate_Text(t *testing.T) {
+	dir := t.TempDir()
+	err := os.WriteFile(filepath.Join(dir, "report.tmpl"), []byte("{{ .Rule }}"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configPath := filepath.Join(dir, ".protolint.yaml")
+
+	for _, test := range []struct {
+		name            string
+		inputTemplate   config.ReporterTemplate
+		inputConfigPath string
+		wantText        string
+		wantExistErr    bool
+	}{
+		{
+			name:     "no template",
+			wantText: "",
+		},
+		{
+			name: "inline template",
+			inputTemplate: config.ReporterTemplate{
+				Inline: "{{ .File }}",
+				File:   "report.tmpl",
+			},
+			inputConfigPath: configPath,
+			wantText:        "{{ .File }}",
+		},
+		{
+			name: "file relative to the config file",
+			inputTemplate: config.ReporterTemplate{
+				File: "report.tmpl",
+			},
+			inputConfigPath: configPath,
+			wantText:        "{{ .Rule }}",
+		},
+		{
+			name: "absolute file",
+			inputTemplate: config.ReporterTemplate{
+				File: filepath.Join(dir, "report.tmpl"),
+			},
+			wantText: "{{ .Rule }}",
+		},
+		{
+			name: "not found file",
+			inputTemplate: config.ReporterTemplate{
+				File: "missing.tmpl",
+			},
+			inputConfigPath: configPath,
+			wantExistErr:    true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.inputTemplate.Text(test.inputConfigPath)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if got != test.wantText {
+				t.Errorf("got %q, but want %q", got, test.wantText)
+			}
+		})
+	}
+}
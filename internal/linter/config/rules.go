@@ -8,6 +8,10 @@ type Rules struct {
 	AllDefault bool     `yaml:"all_default" json:"all_default" toml:"all_default"`
 	Add        []string `yaml:"add" json:"add" toml:"add"`
 	Remove     []string `yaml:"remove" json:"remove" toml:"remove"`
+	// AddCategories adds the rules in the categories. Remove takes precedence over it.
+	AddCategories []string `yaml:"add_categories" json:"add_categories" toml:"add_categories"`
+	// RemoveCategories removes the rules in the categories. Add takes precedence over it.
+	RemoveCategories []string `yaml:"remove_categories" json:"remove_categories" toml:"remove_categories"`
 }
 
 func (r Rules) shouldSkipRule(
@@ -32,3 +36,58 @@ func (r Rules) shouldSkipRule(
 
 	return !stringsutil.ContainsStringInSlice(ruleID, newRuleIDs)
 }
+
+// WithSelection returns the rules layered with the selection, for example from the command line.
+// A non-empty only replaces the enabled rules. Then, enable adds rules and disable removes rules.
+func (r Rules) WithSelection(
+	only []string,
+	enable []string,
+	disable []string,
+) Rules {
+	if 0 < len(only) {
+		r = Rules{
+			NoDefault: true,
+			Add:       only,
+		}
+	}
+
+	var remove []string
+	for _, id := range r.Remove {
+		if !stringsutil.ContainsStringInSlice(id, enable) {
+			remove = append(remove, id)
+		}
+	}
+	r.Add = append(append([]string{}, r.Add...), enable...)
+	r.Remove = append(remove, disable...)
+	return r
+}
+
+// WithCategories returns the rules whose categories are expanded to the rule ids in Add and Remove.
+// The rule ids in Add and Remove take precedence over the categories.
+func (r Rules) WithCategories(
+	idsByCategory map[string][]string,
+) Rules {
+	add := append([]string{}, r.Add...)
+	for _, category := range r.AddCategories {
+		for _, id := range idsByCategory[category] {
+			if !stringsutil.ContainsStringInSlice(id, r.Remove) {
+				add = append(add, id)
+			}
+		}
+	}
+
+	remove := append([]string{}, r.Remove...)
+	for _, category := range r.RemoveCategories {
+		for _, id := range idsByCategory[category] {
+			if !stringsutil.ContainsStringInSlice(id, r.Add) {
+				remove = append(remove, id)
+			}
+		}
+	}
+
+	r.Add = add
+	r.Remove = remove
+	r.AddCategories = nil
+	r.RemoveCategories = nil
+	return r
+}
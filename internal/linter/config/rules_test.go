@@ -0,0 +1,113 @@
+package config_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/linter/config"
+)
+
+func TestRules_WithSelection(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		inputRules   config.Rules
+		inputOnly    []string
+		inputEnable  []string
+		inputDisable []string
+		wantRules    config.Rules
+	}{
+		{
+			name: "no selection",
+			inputRules: config.Rules{
+				Add:    []string{"FILE_HAS_COMMENT"},
+				Remove: []string{"ORDER"},
+			},
+			wantRules: config.Rules{
+				Add:    []string{"FILE_HAS_COMMENT"},
+				Remove: []string{"ORDER"},
+			},
+		},
+		{
+			name: "enable and disable are layered on the config",
+			inputRules: config.Rules{
+				AllDefault: true,
+				Add:        []string{"FILE_HAS_COMMENT"},
+				Remove:     []string{"ORDER", "INDENT"},
+			},
+			inputEnable:  []string{"ORDER"},
+			inputDisable: []string{"FILE_HAS_COMMENT"},
+			wantRules: config.Rules{
+				AllDefault: true,
+				Add:        []string{"FILE_HAS_COMMENT", "ORDER"},
+				Remove:     []string{"INDENT", "FILE_HAS_COMMENT"},
+			},
+		},
+		{
+			name: "only replaces the config",
+			inputRules: config.Rules{
+				Add:    []string{"FILE_HAS_COMMENT"},
+				Remove: []string{"ORDER"},
+			},
+			inputOnly:    []string{"ORDER", "INDENT"},
+			inputDisable: []string{"INDENT"},
+			wantRules: config.Rules{
+				NoDefault: true,
+				Add:       []string{"ORDER", "INDENT"},
+				Remove:    []string{"INDENT"},
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := test.inputRules.WithSelection(test.inputOnly, test.inputEnable, test.inputDisable)
+			if !reflect.DeepEqual(got, test.wantRules) {
+				t.Errorf("got %v, but want %v", got, test.wantRules)
+			}
+		})
+	}
+}
+
+func TestRules_WithCategories(t *testing.T) {
+	idsByCategory := map[string][]string{
+		"naming":        {"ENUM_NAMES_UPPER_CAMEL_CASE", "FIELD_NAMES_LOWER_SNAKE_CASE"},
+		"documentation": {"FILE_HAS_COMMENT", "FIELDS_HAVE_COMMENT"},
+	}
+	for _, test := range []struct {
+		name       string
+		inputRules config.Rules
+		wantRules  config.Rules
+	}{
+		{
+			name: "no categories",
+			inputRules: config.Rules{
+				Add:    []string{"FILE_HAS_COMMENT"},
+				Remove: []string{"ORDER"},
+			},
+			wantRules: config.Rules{
+				Add:    []string{"FILE_HAS_COMMENT"},
+				Remove: []string{"ORDER"},
+			},
+		},
+		{
+			name: "expands the categories, and the rule ids take precedence",
+			inputRules: config.Rules{
+				Add:              []string{"FIELD_NAMES_LOWER_SNAKE_CASE"},
+				Remove:           []string{"FIELDS_HAVE_COMMENT"},
+				AddCategories:    []string{"documentation", "unknown"},
+				RemoveCategories: []string{"naming"},
+			},
+			wantRules: config.Rules{
+				Add:    []string{"FIELD_NAMES_LOWER_SNAKE_CASE", "FILE_HAS_COMMENT"},
+				Remove: []string{"FIELDS_HAVE_COMMENT", "ENUM_NAMES_UPPER_CAMEL_CASE"},
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := test.inputRules.WithCategories(idsByCategory)
+			if !reflect.DeepEqual(got, test.wantRules) {
+				t.Errorf("got %v, but want %v", got, test.wantRules)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package file
+
+import (
+	"sync"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+)
+
+// parsed maps the protos to the files they are parsed from.
+// The rules get only the proto, but need the file to read and fix the content, which can live only in memory.
+// Unlike a map keyed by the path, it doesn't depend on the working directory,
+// and the files with the same path in different lint runs don't interfere with each other.
+var parsed sync.Map
+
+func bind(
+	proto *parser.Proto,
+	f ProtoFile,
+) {
+	parsed.Store(proto, f)
+}
+
+// Release unbinds the protos from the files they are parsed from.
+// Call it when the protos are no longer used, so that they can be garbage collected.
+func Release(protos ...*parser.Proto) {
+	for _, proto := range protos {
+		parsed.Delete(proto)
+	}
+}
+
+// FromProto returns the file which the proto is parsed from by ProtoFile.Parse.
+// Otherwise, it returns the file on disk at the filename of the proto.
+func FromProto(proto *parser.Proto) ProtoFile {
+	if f, ok := parsed.Load(proto); ok {
+		return f.(ProtoFile)
+	}
+
+	var filename string
+	if proto != nil && proto.Meta != nil {
+		filename = proto.Meta.Filename
+	}
+	return FromFilename(filename)
+}
+
+// FromFilename returns the file on disk at the filename, which is relative to the working directory.
+func FromFilename(filename string) ProtoFile {
+	path, err := absClean(filename)
+	if err != nil {
+		path = filename
+	}
+	return NewProtoFile(path, filename)
+}
@@ -1,10 +1,14 @@
 package file
 
 import (
+	"bytes"
 	"os"
+	"sync"
 
 	protoparser "github.com/yoheimuta/go-protoparser/v4"
 	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/yoheimuta/protolint/internal/osutil"
 )
 
 // ProtoFile is a Protocol Buffer file.
@@ -17,6 +21,14 @@ type ProtoFile struct {
 	// This will be relative to the working directory, or the absolute path
 	// if the file was outside the working directory.
 	displayPath string
+	// The content of the file which lives only in memory. It's nil for a file on disk.
+	// It's shared by the copies of the ProtoFile, so that a fix through one of them is seen by all.
+	memContent *memContent
+}
+
+type memContent struct {
+	mu      sync.Mutex
+	content []byte
 }
 
 // NewProtoFile creates a new proto file.
@@ -30,26 +42,53 @@ func NewProtoFile(
 	}
 }
 
+// NewMemProtoFile creates a new proto file whose content lives only in memory, like the one read from stdin.
+// The path is used to display in output and needn't exist.
+func NewMemProtoFile(
+	path string,
+	content []byte,
+) (ProtoFile, error) {
+	return NewMemProtoFileFrom("", path, content)
+}
+
+// NewMemProtoFileFrom creates a new in-memory ProtoFile like NewMemProtoFile.
+// The relative path is resolved against workDirPath, and the display path is relative to it.
+// An empty workDirPath means the working directory.
+func NewMemProtoFileFrom(
+	workDirPath string,
+	path string,
+	content []byte,
+) (ProtoFile, error) {
+	absCwd, err := absWorkDirFrom(workDirPath)
+	if err != nil {
+		return ProtoFile{}, err
+	}
+	absPath, err := absCleanFrom(absCwd, path)
+	if err != nil {
+		return ProtoFile{}, err
+	}
+
+	return NewProtoFile(absPath, relDisplayPath(absCwd, absPath)).WithMemContent(content), nil
+}
+
+// WithMemContent returns the file whose content lives only in memory instead of on disk.
+func (f ProtoFile) WithMemContent(content []byte) ProtoFile {
+	f.memContent = &memContent{content: content}
+	return f
+}
+
 // Parse parses a Protocol Buffer file.
+// The rules find the file from the returned proto with FromProto until Release is called.
 func (f ProtoFile) Parse(
 	debug bool,
-) (_ *parser.Proto, err error) {
-	reader, err := os.Open(f.path)
+) (*parser.Proto, error) {
+	content, err := f.Content()
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		closeErr := reader.Close()
-		if err != nil {
-			return
-		}
-		if closeErr != nil {
-			err = closeErr
-		}
-	}()
 
 	proto, err := protoparser.Parse(
-		reader,
+		bytes.NewReader(content),
 		protoparser.WithFilename(f.displayPath),
 		protoparser.WithBodyIncludingComments(true),
 		protoparser.WithDebug(debug),
@@ -57,9 +96,37 @@ func (f ProtoFile) Parse(
 	if err != nil {
 		return nil, err
 	}
+	bind(proto, f)
 	return proto, nil
 }
 
+// Content returns the content of the file.
+func (f ProtoFile) Content() ([]byte, error) {
+	if f.memContent == nil {
+		return os.ReadFile(f.path)
+	}
+	f.memContent.mu.Lock()
+	defer f.memContent.mu.Unlock()
+	return f.memContent.content, nil
+}
+
+// WriteContent replaces the content of the file.
+// An in-memory file is replaced in memory, and the file on disk is left untouched.
+func (f ProtoFile) WriteContent(content []byte) error {
+	if f.memContent == nil {
+		return osutil.WriteExistingFile(f.path, content)
+	}
+	f.memContent.mu.Lock()
+	defer f.memContent.mu.Unlock()
+	f.memContent.content = content
+	return nil
+}
+
+// IsInMemory reports whether the content of the file lives only in memory.
+func (f ProtoFile) IsInMemory() bool {
+	return f.memContent != nil
+}
+
 // Path returns the path to the .proto file.
 func (f ProtoFile) Path() string {
 	return f.path
@@ -0,0 +1,87 @@
+package file_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/linter/file"
+)
+
+const testProto = "syntax = \"proto3\";\n"
+
+func TestProtoFile_WriteContent(t *testing.T) {
+	dir := t.TempDir()
+	diskPath := filepath.Join(dir, "disk.proto")
+	err := os.WriteFile(diskPath, []byte(testProto), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	memFile, err := file.NewMemProtoFileFrom(dir, "mem.proto", []byte(testProto))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name         string
+		inputFile    file.ProtoFile
+		wantInMemory bool
+	}{
+		{
+			name:      "reads and writes the file on disk",
+			inputFile: file.NewProtoFile(diskPath, "disk.proto"),
+		},
+		{
+			name:         "reads and writes the content in memory",
+			inputFile:    memFile,
+			wantInMemory: true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			if test.inputFile.IsInMemory() != test.wantInMemory {
+				t.Errorf("got in memory %v, but want %v", test.inputFile.IsInMemory(), test.wantInMemory)
+			}
+
+			// A copy of the file sees the written content.
+			copied := test.inputFile
+			err := test.inputFile.WriteContent([]byte("fixed"))
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			got, err := copied.Content()
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			if string(got) != "fixed" {
+				t.Errorf("got %q, but want %q", got, "fixed")
+			}
+
+			_, err = os.Stat(test.inputFile.Path())
+			if gotDisk := err == nil; gotDisk == test.wantInMemory {
+				t.Errorf("got the file on disk %v, but want %v", gotDisk, !test.wantInMemory)
+			}
+		})
+	}
+}
+
+func TestFromProto(t *testing.T) {
+	dir := t.TempDir()
+	memFile, err := file.NewMemProtoFileFrom(dir, "mem.proto", []byte(testProto))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proto, err := memFile.Parse(false)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	if got := file.FromProto(proto); got.Path() != memFile.Path() || !got.IsInMemory() {
+		t.Errorf("got %v, but want the file the proto is parsed from", got)
+	}
+
+	file.Release(proto)
+	if got := file.FromProto(proto); got.Path() == memFile.Path() || got.IsInMemory() {
+		t.Errorf("got %v, but want the file on disk at the filename after release", got)
+	}
+}
@@ -15,7 +15,17 @@ type ProtoSet struct {
 func NewProtoSet(
 	targetPaths []string,
 ) (ProtoSet, error) {
-	fs, err := collectAllProtoFilesFromArgs(targetPaths)
+	return NewProtoSetFrom("", targetPaths)
+}
+
+// NewProtoSetFrom creates a new ProtoSet like NewProtoSet.
+// The relative paths are resolved against workDirPath, and the display paths are relative to it.
+// An empty workDirPath means the working directory.
+func NewProtoSetFrom(
+	workDirPath string,
+	targetPaths []string,
+) (ProtoSet, error) {
+	fs, err := collectAllProtoFilesFromArgs(workDirPath, targetPaths)
 	if err != nil {
 		return ProtoSet{}, err
 	}
@@ -34,24 +44,17 @@ func (s ProtoSet) ProtoFiles() []ProtoFile {
 }
 
 func collectAllProtoFilesFromArgs(
+	workDirPath string,
 	targetPaths []string,
 ) ([]ProtoFile, error) {
-	cwd, err := os.Getwd()
-	if err != nil {
-		return nil, err
-	}
-	absCwd, err := absClean(cwd)
+	absCwd, err := absWorkDirFrom(workDirPath)
 	if err != nil {
 		return nil, err
 	}
-	// Eval a possible symlink for the cwd to calculate the correct relative paths in the next step.
-	if newPath, err := filepath.EvalSymlinks(absCwd); err == nil {
-		absCwd = newPath
-	}
 
 	var fs []ProtoFile
 	for _, path := range targetPaths {
-		absTarget, err := absClean(path)
+		absTarget, err := absCleanFrom(absCwd, path)
 		if err != nil {
 			return nil, err
 		}
@@ -81,12 +84,7 @@ func collectAllProtoFiles(
 				return nil
 			}
 
-			displayPath, err := filepath.Rel(absWorkDirPath, path)
-			if err != nil {
-				displayPath = path
-			}
-			displayPath = filepath.Clean(displayPath)
-			fs = append(fs, NewProtoFile(path, displayPath))
+			fs = append(fs, NewProtoFile(path, relDisplayPath(absWorkDirPath, path)))
 			return nil
 		},
 	)
@@ -106,3 +104,52 @@ func absClean(path string) (string, error) {
 	}
 	return filepath.Clean(path), nil
 }
+
+// absCleanFrom returns the cleaned absolute path of the given path, which is relative to absDirPath if not absolute.
+func absCleanFrom(
+	absDirPath string,
+	path string,
+) (string, error) {
+	if path == "" || filepath.IsAbs(path) {
+		return absClean(path)
+	}
+	return filepath.Join(absDirPath, path), nil
+}
+
+// absWorkDir returns the cleaned absolute path of the working directory.
+func absWorkDir() (string, error) {
+	return absWorkDirFrom("")
+}
+
+// absWorkDirFrom returns the cleaned absolute path of workDirPath, or the working directory if it's empty.
+func absWorkDirFrom(workDirPath string) (string, error) {
+	cwd := workDirPath
+	if cwd == "" {
+		var err error
+		cwd, err = os.Getwd()
+		if err != nil {
+			return "", err
+		}
+	}
+	absCwd, err := absClean(cwd)
+	if err != nil {
+		return "", err
+	}
+	// Eval a possible symlink for the cwd to calculate the correct relative paths in the next step.
+	if newPath, err := filepath.EvalSymlinks(absCwd); err == nil {
+		absCwd = newPath
+	}
+	return absCwd, nil
+}
+
+// relDisplayPath returns the path relative to the working directory to display in output.
+func relDisplayPath(
+	absWorkDirPath string,
+	absPath string,
+) string {
+	displayPath, err := filepath.Rel(absWorkDirPath, absPath)
+	if err != nil {
+		displayPath = absPath
+	}
+	return filepath.Clean(displayPath)
+}
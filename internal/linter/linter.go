@@ -3,6 +3,7 @@ package linter
 import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
+	"github.com/yoheimuta/protolint/internal/linter/file"
 	"github.com/yoheimuta/protolint/linter/report"
 	"github.com/yoheimuta/protolint/linter/rule"
 )
@@ -16,6 +17,7 @@ func NewLinter() *Linter {
 }
 
 // Run lints the protocol buffer.
+// Each proto is released from its file after the rule is applied, since genProto parses a new one for the next rule.
 func (l *Linter) Run(
 	genProto func(*parser.Proto) (*parser.Proto, error),
 	hasApplies []rule.HasApply,
@@ -31,6 +33,7 @@ func (l *Linter) Run(
 		}
 
 		f, err := hasApply.Apply(p)
+		file.Release(p)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,209 @@
+package rename
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+)
+
+// reference is a type name written in the source.
+type reference struct {
+	// name is the name as it's written.
+	name  string
+	scope string
+	// offset is the byte offset of the name in the content.
+	offset int
+	// quoted is true for a string constant of an option, which refers to a type only by the full name.
+	quoted bool
+}
+
+// references collects the type names of the fields, the rpc requests and responses, the extend targets and the option values.
+func references(proto *parser.Proto, content []byte) []reference {
+	c := &collector{content: content}
+	c.walk(packageName(proto), proto.ProtoBody)
+	return c.refs
+}
+
+type collector struct {
+	content []byte
+	refs    []reference
+}
+
+func (c *collector) walk(scope string, body []parser.Visitee) {
+	for _, v := range body {
+		switch e := v.(type) {
+		case *parser.Message:
+			c.walk(joinName(scope, e.MessageName), e.MessageBody)
+		case *parser.GroupField:
+			c.walk(joinName(scope, e.GroupName), e.MessageBody)
+		case *parser.Enum:
+			for _, ev := range e.EnumBody {
+				switch f := ev.(type) {
+				case *parser.EnumField:
+					from := findToken(c.content, f.Meta.Pos.Offset, f.Ident)
+					for _, o := range f.EnumValueOptions {
+						from = c.addOptionValue(scope, from, o.OptionName, o.Constant)
+					}
+				case *parser.Option:
+					c.addOption(scope, f)
+				}
+			}
+		case *parser.Field:
+			from := c.add(scope, e.Meta.Pos.Offset, e.Type)
+			c.addFieldOptions(scope, from, e.FieldOptions)
+		case *parser.MapField:
+			comma := indexFrom(c.content, e.Meta.Pos.Offset, ",")
+			from := c.add(scope, comma, e.Type)
+			c.addFieldOptions(scope, from, e.FieldOptions)
+		case *parser.Oneof:
+			for _, f := range e.OneofFields {
+				from := c.add(scope, f.Meta.Pos.Offset, f.Type)
+				c.addFieldOptions(scope, from, f.FieldOptions)
+			}
+			for _, o := range e.Options {
+				c.addOption(scope, o)
+			}
+		case *parser.Extend:
+			c.add(scope, e.Meta.Pos.Offset+len("extend"), e.MessageType)
+			c.walk(scope, e.ExtendBody)
+		case *parser.Service:
+			for _, sv := range e.ServiceBody {
+				switch s := sv.(type) {
+				case *parser.RPC:
+					c.addRPC(scope, s)
+				case *parser.Option:
+					c.addOption(scope, s)
+				}
+			}
+		case *parser.Option:
+			c.addOption(scope, e)
+		}
+	}
+}
+
+// add adds the reference found first from the offset, and returns the offset just after it.
+func (c *collector) add(scope string, from int, name string) int {
+	offset := findToken(c.content, from, name)
+	if offset < 0 {
+		return from
+	}
+	c.refs = append(c.refs, reference{
+		name:   name,
+		scope:  scope,
+		offset: offset,
+	})
+	return offset + len(name)
+}
+
+func (c *collector) addRPC(scope string, rpc *parser.RPC) {
+	from := indexFrom(c.content, rpc.Meta.Pos.Offset, "(")
+	from = c.add(scope, from, rpc.RPCRequest.MessageType)
+	from = findToken(c.content, from, "returns")
+	c.add(scope, from, rpc.RPCResponse.MessageType)
+	for _, o := range rpc.Options {
+		c.addOption(scope, o)
+	}
+}
+
+func (c *collector) addOption(scope string, o *parser.Option) {
+	c.addOptionValue(scope, o.Meta.Pos.Offset, o.OptionName, o.Constant)
+}
+
+func (c *collector) addFieldOptions(scope string, from int, options []*parser.FieldOption) {
+	for _, o := range options {
+		from = c.addOptionValue(scope, from, o.OptionName, o.Constant)
+	}
+}
+
+// addOptionValue adds the value of the option if it can be a type name, and returns the offset just after it.
+func (c *collector) addOptionValue(scope string, from int, name, constant string) int {
+	if from < 0 {
+		return from
+	}
+	from = findToken(c.content, from, name)
+	eq := indexFrom(c.content, from, "=")
+	if eq < 0 {
+		return from
+	}
+
+	if isQuoted(constant) {
+		offset := findToken(c.content, eq, constant)
+		if offset < 0 {
+			return eq
+		}
+		c.refs = append(c.refs, reference{
+			name:   constant[1 : len(constant)-1],
+			scope:  scope,
+			offset: offset + 1,
+			quoted: true,
+		})
+		return offset + len(constant)
+	}
+	if !isFullIdent(constant) {
+		return eq
+	}
+	return c.add(scope, eq, constant)
+}
+
+func isQuoted(s string) bool {
+	return 2 <= len(s) && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0]
+}
+
+func isFullIdent(s string) bool {
+	for _, part := range strings.Split(strings.TrimPrefix(s, "."), ".") {
+		if !isIdent(part) {
+			return false
+		}
+	}
+	return true
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case '0' <= r && r <= '9' && 0 < i:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// indexFrom returns the offset of the first s from the offset, or -1.
+func indexFrom(content []byte, from int, s string) int {
+	if from < 0 || len(content) < from {
+		return -1
+	}
+	i := bytes.Index(content[from:], []byte(s))
+	if i < 0 {
+		return -1
+	}
+	return from + i
+}
+
+// findToken returns the offset of the first token from the offset, or -1.
+// The token doesn't match a part of a longer name.
+func findToken(content []byte, from int, token string) int {
+	for from >= 0 {
+		i := indexFrom(content, from, token)
+		if i < 0 {
+			return -1
+		}
+		end := i + len(token)
+		if (i == 0 || !isNameByte(content[i-1]) || !isNameByte(token[0])) &&
+			(end == len(content) || !isNameByte(content[end]) || !isNameByte(token[len(token)-1])) {
+			return i
+		}
+		from = i + 1
+	}
+	return -1
+}
+
+func isNameByte(b byte) bool {
+	return b == '_' || b == '.' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z' || '0' <= b && b <= '9'
+}
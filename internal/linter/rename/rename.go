@@ -0,0 +1,259 @@
+package rename
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/yoheimuta/protolint/internal/linter/file"
+)
+
+// Renaming represents a message or an enum renamed from OldFullName to NewFullName.
+type Renaming struct {
+	OldFullName string
+	NewFullName string
+}
+
+// Change is the content of a file rewritten by renaming.
+type Change struct {
+	File    file.ProtoFile
+	Content []byte
+	// Edits is the number of the rewritten names, including the declaration.
+	Edits int
+}
+
+// Rename renames the message or the enum with the full name to the new name,
+// and rewrites all references to it in the files together.
+func Rename(
+	files []file.ProtoFile,
+	fullName string,
+	newName string,
+) ([]Change, error) {
+	if !isIdent(newName) {
+		return nil, fmt.Errorf("%q isn't a valid name. Specify the new name without the package and the parents", newName)
+	}
+	fullName = strings.TrimPrefix(fullName, ".")
+
+	parsed, err := parseAll(files)
+	if err != nil {
+		return nil, err
+	}
+	syms := parsed.symbols()
+	if !syms.types[fullName] {
+		return nil, fmt.Errorf("not found the message or the enum %s", fullName)
+	}
+	newFullName := joinName(parentName(fullName), newName)
+	if syms.types[newFullName] {
+		return nil, fmt.Errorf("%s already exists", newFullName)
+	}
+	renaming := Renaming{OldFullName: fullName, NewFullName: newFullName}
+	syms.copyTree(fullName, newFullName)
+	syms.rename(renaming)
+	return parsed.rewrite(syms, []Renaming{renaming}, true), nil
+}
+
+// RewriteReferences rewrites the references to the messages and the enums
+// whose declarations are renamed already, for example by the fixes of the naming rules.
+func RewriteReferences(
+	files []file.ProtoFile,
+	renamings []Renaming,
+) ([]Change, error) {
+	parsed, err := parseAll(files)
+	if err != nil {
+		return nil, err
+	}
+	syms := parsed.symbols()
+	for _, r := range renamings {
+		syms.rename(r)
+	}
+	return parsed.rewrite(syms, renamings, false), nil
+}
+
+// Declarations returns the full names of the messages and the enums in the file in the order of appearance.
+func Declarations(f file.ProtoFile) ([]string, error) {
+	proto, err := f.Parse(false)
+	if err != nil {
+		return nil, err
+	}
+	file.Release(proto)
+
+	var names []string
+	for _, d := range declarations(proto) {
+		names = append(names, d.fullName)
+	}
+	return names, nil
+}
+
+// Diff finds the renamings by comparing the declarations of a file before and after it's modified.
+// It finds nothing if the declarations are added or removed.
+func Diff(before, after []string) []Renaming {
+	if len(before) != len(after) {
+		return nil
+	}
+	var renamings []Renaming
+	for i := range before {
+		if lastName(before[i]) != lastName(after[i]) {
+			renamings = append(renamings, Renaming{
+				OldFullName: before[i],
+				NewFullName: after[i],
+			})
+		}
+	}
+	return renamings
+}
+
+// Apply writes the changes to the files.
+func Apply(changes []Change) error {
+	for _, c := range changes {
+		err := c.File.WriteContent(c.Content)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type parsedFile struct {
+	file    file.ProtoFile
+	proto   *parser.Proto
+	content []byte
+}
+
+type parsedFiles []parsedFile
+
+func parseAll(files []file.ProtoFile) (parsedFiles, error) {
+	var parsed parsedFiles
+	for _, f := range files {
+		content, err := f.Content()
+		if err != nil {
+			return nil, err
+		}
+		proto, err := f.Parse(false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s, err=%s", f.DisplayPath(), err)
+		}
+		// No rule is applied to the proto, which needn't be bound to the file.
+		file.Release(proto)
+		parsed = append(parsed, parsedFile{
+			file:    f,
+			proto:   proto,
+			content: content,
+		})
+	}
+	return parsed, nil
+}
+
+func (ps parsedFiles) symbols() symbols {
+	syms := newSymbols()
+	for _, p := range ps {
+		syms.add(p.proto)
+	}
+	return syms
+}
+
+type edit struct {
+	offset  int
+	length  int
+	newText string
+}
+
+func (ps parsedFiles) rewrite(
+	syms symbols,
+	renamings []Renaming,
+	renameDeclarations bool,
+) []Change {
+	var changes []Change
+	for _, p := range ps {
+		var edits []edit
+		if renameDeclarations {
+			for _, d := range declarations(p.proto) {
+				for _, r := range renamings {
+					if d.fullName != r.OldFullName {
+						continue
+					}
+					offset := findToken(p.content, d.offset+len(d.keyword), d.name)
+					if 0 <= offset {
+						edits = append(edits, edit{offset: offset, length: len(d.name), newText: lastName(r.NewFullName)})
+					}
+				}
+			}
+		}
+		for _, ref := range references(p.proto, p.content) {
+			edits = append(edits, referenceEdits(syms, ref)...)
+		}
+		if len(edits) == 0 {
+			continue
+		}
+
+		content, n := applyEdits(p.content, edits)
+		changes = append(changes, Change{
+			File:    p.file,
+			Content: content,
+			Edits:   n,
+		})
+	}
+	return changes
+}
+
+// referenceEdits rewrites the components of the reference which name the renamed types.
+// A reference written relative to a renamed type, like Inner in the renamed message, is left as it is.
+func referenceEdits(
+	syms symbols,
+	ref reference,
+) []edit {
+	var fullName string
+	var ok bool
+	if ref.quoted {
+		fullName = strings.TrimPrefix(ref.name, ".")
+		ok = syms.isType(fullName)
+	} else {
+		fullName, ok = syms.resolve(ref.name, ref.scope)
+	}
+	if !ok {
+		return nil
+	}
+
+	written := strings.TrimPrefix(ref.name, ".")
+	components := strings.Split(written, ".")
+	normalized, renamedIndexes := syms.normalize(fullName)
+	normalizedComponents := strings.Split(normalized, ".")
+	unwritten := countNames(fullName) - len(components)
+
+	var edits []edit
+	for _, i := range renamedIndexes {
+		if i < unwritten {
+			continue
+		}
+		offset := ref.offset + len(ref.name) - len(written)
+		for _, c := range components[:i-unwritten] {
+			offset += len(c) + 1
+		}
+		edits = append(edits, edit{offset: offset, length: len(components[i-unwritten]), newText: normalizedComponents[i]})
+	}
+	return edits
+}
+
+func countNames(fullName string) int {
+	return strings.Count(fullName, ".") + 1
+}
+
+// applyEdits applies the edits from the last one, so that the offsets of the others stay valid.
+func applyEdits(content []byte, edits []edit) ([]byte, int) {
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].offset > edits[j].offset
+	})
+	newContent := append([]byte{}, content...)
+	n := 0
+	last := -1
+	for _, e := range edits {
+		if e.offset == last {
+			continue
+		}
+		last = e.offset
+		newContent = append(newContent[:e.offset], append([]byte(e.newText), newContent[e.offset+e.length:]...)...)
+		n++
+	}
+	return newContent, n
+}
@@ -0,0 +1,351 @@
+package rename_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/linter/file"
+	"github.com/yoheimuta/protolint/internal/linter/rename"
+)
+
+const (
+	fooProto = `syntax = "proto3";
+package a.b;
+
+// 日本語のコメント
+message Foo {
+  message Inner {}
+  Inner inner = 1;
+  Foo.Inner qualified_inner = 2;
+}
+
+enum Kind {
+  KIND_UNSPECIFIED = 0;
+}
+`
+	barProto = `syntax = "proto2";
+package a.c;
+
+import "foo.proto";
+
+option (type_name) = "a.b.Foo";
+
+message Bar {
+  optional a.b.Foo foo = 1 [(ref) = b.Foo];
+  repeated .a.b.Foo.Inner inners = 2;
+  map<string, b.Foo> foos = 3;
+  oneof value {
+    b.Kind kind = 4;
+  }
+  message Foo {}
+  optional Foo shadowed = 5;
+}
+
+extend a.b.Foo {
+  optional string extra = 100;
+}
+
+service BarService {
+  rpc GetFoo(stream a.b.Foo) returns (stream .a.b.Foo.Inner);
+}
+`
+)
+
+func setUpFiles(t *testing.T) []file.ProtoFile {
+	dir := t.TempDir()
+	var files []file.ProtoFile
+	for _, f := range []struct {
+		name    string
+		content string
+	}{
+		{"foo.proto", fooProto},
+		{"bar.proto", barProto},
+	} {
+		path := filepath.Join(dir, f.name)
+		err := os.WriteFile(path, []byte(f.content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, file.NewProtoFile(path, f.name))
+	}
+	return files
+}
+
+func contents(changes []rename.Change) map[string]string {
+	m := make(map[string]string)
+	for _, c := range changes {
+		m[c.File.DisplayPath()] = string(c.Content)
+	}
+	return m
+}
+
+func TestRename(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		inputFullName string
+		inputNewName  string
+		wantContents  map[string]string
+		wantExistErr  bool
+	}{
+		{
+			name:          "rename a message and the references across the files",
+			inputFullName: "a.b.Foo",
+			inputNewName:  "Baz",
+			wantContents: map[string]string{
+				"foo.proto": `syntax = "proto3";
+package a.b;
+
+// 日本語のコメント
+message Baz {
+  message Inner {}
+  Inner inner = 1;
+  Baz.Inner qualified_inner = 2;
+}
+
+enum Kind {
+  KIND_UNSPECIFIED = 0;
+}
+`,
+				"bar.proto": `syntax = "proto2";
+package a.c;
+
+import "foo.proto";
+
+option (type_name) = "a.b.Baz";
+
+message Bar {
+  optional a.b.Baz foo = 1 [(ref) = b.Baz];
+  repeated .a.b.Baz.Inner inners = 2;
+  map<string, b.Baz> foos = 3;
+  oneof value {
+    b.Kind kind = 4;
+  }
+  message Foo {}
+  optional Foo shadowed = 5;
+}
+
+extend a.b.Baz {
+  optional string extra = 100;
+}
+
+service BarService {
+  rpc GetFoo(stream a.b.Baz) returns (stream .a.b.Baz.Inner);
+}
+`,
+			},
+		},
+		{
+			name:          "rename a nested message",
+			inputFullName: ".a.b.Foo.Inner",
+			inputNewName:  "Nested",
+			wantContents: map[string]string{
+				"foo.proto": `syntax = "proto3";
+package a.b;
+
+// 日本語のコメント
+message Foo {
+  message Nested {}
+  Nested inner = 1;
+  Foo.Nested qualified_inner = 2;
+}
+
+enum Kind {
+  KIND_UNSPECIFIED = 0;
+}
+`,
+				"bar.proto": `syntax = "proto2";
+package a.c;
+
+import "foo.proto";
+
+option (type_name) = "a.b.Foo";
+
+message Bar {
+  optional a.b.Foo foo = 1 [(ref) = b.Foo];
+  repeated .a.b.Foo.Nested inners = 2;
+  map<string, b.Foo> foos = 3;
+  oneof value {
+    b.Kind kind = 4;
+  }
+  message Foo {}
+  optional Foo shadowed = 5;
+}
+
+extend a.b.Foo {
+  optional string extra = 100;
+}
+
+service BarService {
+  rpc GetFoo(stream a.b.Foo) returns (stream .a.b.Foo.Nested);
+}
+`,
+			},
+		},
+		{
+			name:          "rename an enum",
+			inputFullName: "a.b.Kind",
+			inputNewName:  "Type",
+			wantContents: map[string]string{
+				"foo.proto": `syntax = "proto3";
+package a.b;
+
+// 日本語のコメント
+message Foo {
+  message Inner {}
+  Inner inner = 1;
+  Foo.Inner qualified_inner = 2;
+}
+
+enum Type {
+  KIND_UNSPECIFIED = 0;
+}
+`,
+				"bar.proto": `syntax = "proto2";
+package a.c;
+
+import "foo.proto";
+
+option (type_name) = "a.b.Foo";
+
+message Bar {
+  optional a.b.Foo foo = 1 [(ref) = b.Foo];
+  repeated .a.b.Foo.Inner inners = 2;
+  map<string, b.Foo> foos = 3;
+  oneof value {
+    b.Type kind = 4;
+  }
+  message Foo {}
+  optional Foo shadowed = 5;
+}
+
+extend a.b.Foo {
+  optional string extra = 100;
+}
+
+service BarService {
+  rpc GetFoo(stream a.b.Foo) returns (stream .a.b.Foo.Inner);
+}
+`,
+			},
+		},
+		{
+			name:          "not found the type",
+			inputFullName: "a.b.Unknown",
+			inputNewName:  "Baz",
+			wantExistErr:  true,
+		},
+		{
+			name:          "the new name already exists",
+			inputFullName: "a.b.Foo",
+			inputNewName:  "Kind",
+			wantExistErr:  true,
+		},
+		{
+			name:          "the new name is qualified",
+			inputFullName: "a.b.Foo",
+			inputNewName:  "a.b.Baz",
+			wantExistErr:  true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			files := setUpFiles(t)
+
+			got, err := rename.Rename(files, test.inputFullName, test.inputNewName)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			gotContents := contents(got)
+			if !reflect.DeepEqual(gotContents, test.wantContents) {
+				t.Errorf("got %v, but want %v", gotContents, test.wantContents)
+			}
+		})
+	}
+}
+
+func TestRewriteReferences(t *testing.T) {
+	files := setUpFiles(t)
+	before, err := rename.Declarations(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rename the declarations only, as the fixes of the naming rules do.
+	changes, err := rename.Rename(files[:1], "a.b.Foo", "Baz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = rename.Apply(changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	after, err := rename.Declarations(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	renamings := rename.Diff(before, after)
+	wantRenamings := []rename.Renaming{
+		{OldFullName: "a.b.Foo", NewFullName: "a.b.Baz"},
+	}
+	if !reflect.DeepEqual(renamings, wantRenamings) {
+		t.Fatalf("got %v, but want %v", renamings, wantRenamings)
+	}
+
+	got, err := rename.RewriteReferences(files, renamings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].File.DisplayPath() != "bar.proto" {
+		t.Fatalf("got %v, but want only bar.proto", contents(got))
+	}
+	if got[0].Edits != 8 {
+		t.Errorf("got %d edits, but want 8", got[0].Edits)
+	}
+}
+
+func TestRewriteReferences_RenamedParentAndChild(t *testing.T) {
+	dir := t.TempDir()
+	fooPath := filepath.Join(dir, "foo.proto")
+	err := os.WriteFile(fooPath, []byte(`syntax = "proto3";
+package a.b;
+message FooBar {
+  message InnerX {}
+  inner_x x = 1;
+  foo_bar.inner_x y = 2;
+}
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := []file.ProtoFile{file.NewProtoFile(fooPath, "foo.proto")}
+
+	got, err := rename.RewriteReferences(files, []rename.Renaming{
+		{OldFullName: "a.b.foo_bar", NewFullName: "a.b.FooBar"},
+		{OldFullName: "a.b.foo_bar.inner_x", NewFullName: "a.b.FooBar.InnerX"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"foo.proto": `syntax = "proto3";
+package a.b;
+message FooBar {
+  message InnerX {}
+  InnerX x = 1;
+  FooBar.InnerX y = 2;
+}
+`,
+	}
+	if !reflect.DeepEqual(contents(got), want) {
+		t.Errorf("got %v, but want %v", contents(got), want)
+	}
+}
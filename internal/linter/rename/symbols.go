@@ -0,0 +1,160 @@
+package rename
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+)
+
+// symbols holds the full names of the messages, the enums and the packages in the proto set.
+type symbols struct {
+	types    map[string]bool
+	packages map[string]bool
+	// renamed maps the renamed types, keyed by the new full name of the parent and the old name, to the new names.
+	renamed map[renamedKey]string
+}
+
+type renamedKey struct {
+	parent  string
+	oldName string
+}
+
+func newSymbols() symbols {
+	return symbols{
+		types:    make(map[string]bool),
+		packages: make(map[string]bool),
+		renamed:  make(map[renamedKey]string),
+	}
+}
+
+func (s symbols) add(proto *parser.Proto) {
+	pkg := packageName(proto)
+	for p := pkg; p != ""; p = parentName(p) {
+		s.packages[p] = true
+	}
+	for _, d := range declarations(proto) {
+		s.types[d.fullName] = true
+	}
+}
+
+// copyTree adds the names under from as the ones under to.
+func (s symbols) copyTree(from, to string) {
+	for t := range s.types {
+		if t == from || strings.HasPrefix(t, from+".") {
+			s.types[to+strings.TrimPrefix(t, from)] = true
+		}
+	}
+}
+
+// rename makes the old name of the renamed type resolve to the new one, regardless of whether the parents are renamed.
+func (s symbols) rename(r Renaming) {
+	s.renamed[renamedKey{parent: parentName(r.NewFullName), oldName: lastName(r.OldFullName)}] = lastName(r.NewFullName)
+}
+
+// normalize spells the full name with the new names of the renamed types.
+// It also returns the indexes of the renamed components.
+func (s symbols) normalize(fullName string) (string, []int) {
+	var normalized string
+	var renamedIndexes []int
+	for i, name := range strings.Split(fullName, ".") {
+		if newName, ok := s.renamed[renamedKey{parent: normalized, oldName: name}]; ok {
+			name = newName
+			renamedIndexes = append(renamedIndexes, i)
+		}
+		normalized = joinName(normalized, name)
+	}
+	return normalized, renamedIndexes
+}
+
+func (s symbols) isType(fullName string) bool {
+	normalized, _ := s.normalize(fullName)
+	return s.types[normalized]
+}
+
+// resolve resolves the type reference in the scope according to the scoping rules of Protocol Buffers.
+// The first component of the reference is searched from the innermost scope to the outermost one.
+// It returns the full name spelled as the reference.
+func (s symbols) resolve(ref, scope string) (string, bool) {
+	if strings.HasPrefix(ref, ".") {
+		fullName := strings.TrimPrefix(ref, ".")
+		return fullName, s.isType(fullName)
+	}
+
+	first := strings.SplitN(ref, ".", 2)[0]
+	for sc := scope; ; sc = parentName(sc) {
+		candidate := joinName(sc, first)
+		if s.isType(candidate) || s.packages[candidate] {
+			fullName := joinName(sc, ref)
+			return fullName, s.isType(fullName)
+		}
+		if sc == "" {
+			return "", false
+		}
+	}
+}
+
+// declaration is a message or an enum.
+type declaration struct {
+	fullName string
+	keyword  string
+	name     string
+	offset   int
+}
+
+// declarations returns the messages and the enums in the proto, including the nested ones, in the order of appearance.
+func declarations(proto *parser.Proto) []declaration {
+	var ds []declaration
+	var walk func(scope string, body []parser.Visitee)
+	walk = func(scope string, body []parser.Visitee) {
+		for _, v := range body {
+			switch e := v.(type) {
+			case *parser.Message:
+				fullName := joinName(scope, e.MessageName)
+				ds = append(ds, declaration{
+					fullName: fullName,
+					keyword:  "message",
+					name:     e.MessageName,
+					offset:   e.Meta.Pos.Offset,
+				})
+				walk(fullName, e.MessageBody)
+			case *parser.Enum:
+				ds = append(ds, declaration{
+					fullName: joinName(scope, e.EnumName),
+					keyword:  "enum",
+					name:     e.EnumName,
+					offset:   e.Meta.Pos.Offset,
+				})
+			}
+		}
+	}
+	walk(packageName(proto), proto.ProtoBody)
+	return ds
+}
+
+func packageName(proto *parser.Proto) string {
+	for _, v := range proto.ProtoBody {
+		if p, ok := v.(*parser.Package); ok {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+func parentName(fullName string) string {
+	i := strings.LastIndex(fullName, ".")
+	if i < 0 {
+		return ""
+	}
+	return fullName[:i]
+}
+
+func lastName(fullName string) string {
+	return fullName[strings.LastIndex(fullName, ".")+1:]
+}
+
+func joinName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
@@ -4,6 +4,8 @@ import (
 	"io"
 	"os"
 
+	"github.com/yoheimuta/protolint/internal/linter/config"
+	"github.com/yoheimuta/protolint/internal/linter/rule"
 	"github.com/yoheimuta/protolint/linter/report"
 )
 
@@ -16,6 +18,30 @@ type Reporter interface {
 	Report(io.Writer, []report.Failure) error
 }
 
+// RuleInfosReporter is a Reporter which can describe the reported rules in detail.
+// A Reporter can optionally implement it.
+type RuleInfosReporter interface {
+	Reporter
+	// WithRuleInfos returns the reporter which describes the rules with the infos.
+	WithRuleInfos(infos []rule.Info) Reporter
+}
+
+// TemplateReporter is a Reporter which renders a template, which the config can give.
+// A Reporter can optionally implement it.
+type TemplateReporter interface {
+	Reporter
+	// WithDefaultTemplate returns the reporter which renders the template unless it has one already.
+	WithDefaultTemplate(text string) (Reporter, error)
+}
+
+// MarkdownOptionReporter is a Reporter which is configured by the markdown reporter option.
+// A Reporter can optionally implement it.
+type MarkdownOptionReporter interface {
+	Reporter
+	// WithMarkdownOption returns the reporter which is configured by the option.
+	WithMarkdownOption(option config.MarkdownReporterOption) Reporter
+}
+
 type ReporterWithOutput struct {
 	reporter   Reporter
 	targetFile string
@@ -23,16 +49,22 @@ type ReporterWithOutput struct {
 
 type ReportersWithOutput []ReporterWithOutput
 
+// ReportWithFallback reports the failures to the target file, or w if it's the console.
+// The target file is truncated and closed every time, because watch mode reports repeatedly.
 func (ro ReporterWithOutput) ReportWithFallback(w io.Writer, failures []report.Failure) error {
-	if ro.targetFile != WriteToConsole {
-		var err error
-		w, err = os.OpenFile(ro.targetFile, os.O_WRONLY|os.O_CREATE, 0666)
-		if err != nil {
-			return err
-		}
+	if ro.targetFile == WriteToConsole {
+		return ro.reporter.Report(w, failures)
 	}
 
-	return ro.reporter.Report(w, failures)
+	f, err := os.OpenFile(ro.targetFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+	err = ro.reporter.Report(f, failures)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
 }
 
 func (ros ReportersWithOutput) ReportWithFallback(w io.Writer, failures []report.Failure) error {
@@ -46,6 +78,68 @@ func (ros ReportersWithOutput) ReportWithFallback(w io.Writer, failures []report
 	return nil
 }
 
+// WithRuleInfos returns the reporters which describe the rules with the infos if they can.
+func (ros ReportersWithOutput) WithRuleInfos(infos []rule.Info) ReportersWithOutput {
+	var newReporters ReportersWithOutput
+	for _, ro := range ros {
+		if r, ok := ro.reporter.(RuleInfosReporter); ok {
+			ro.reporter = r.WithRuleInfos(infos)
+		}
+		newReporters = append(newReporters, ro)
+	}
+	return newReporters
+}
+
+// HasTemplateReporter checks whether or not any reporter renders a template.
+func (ros ReportersWithOutput) HasTemplateReporter() bool {
+	for _, ro := range ros {
+		if _, ok := ro.reporter.(TemplateReporter); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// WithDefaultTemplate returns the reporters which render the template unless they have one already.
+func (ros ReportersWithOutput) WithDefaultTemplate(text string) (ReportersWithOutput, error) {
+	var newReporters ReportersWithOutput
+	for _, ro := range ros {
+		if r, ok := ro.reporter.(TemplateReporter); ok {
+			reporter, err := r.WithDefaultTemplate(text)
+			if err != nil {
+				return nil, err
+			}
+			ro.reporter = reporter
+		}
+		newReporters = append(newReporters, ro)
+	}
+	return newReporters, nil
+}
+
+// WithMarkdownOption returns the reporters which are configured by the option if they can.
+func (ros ReportersWithOutput) WithMarkdownOption(option config.MarkdownReporterOption) ReportersWithOutput {
+	var newReporters ReportersWithOutput
+	for _, ro := range ros {
+		if r, ok := ro.reporter.(MarkdownOptionReporter); ok {
+			ro.reporter = r.WithMarkdownOption(option)
+		}
+		newReporters = append(newReporters, ro)
+	}
+	return newReporters
+}
+
+// WithRunInfo returns the reporters which describe the run with the info if they can.
+func (ros ReportersWithOutput) WithRunInfo(info RunInfo) ReportersWithOutput {
+	var newReporters ReportersWithOutput
+	for _, ro := range ros {
+		if r, ok := ro.reporter.(RunInfoReporter); ok {
+			ro.reporter = r.WithRunInfo(info)
+		}
+		newReporters = append(newReporters, ro)
+	}
+	return newReporters
+}
+
 func NewReporterWithOutput(r Reporter, targetFile string) *ReporterWithOutput {
 	return &ReporterWithOutput{r, targetFile}
 }
@@ -0,0 +1,50 @@
+package report_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+func TestReportersWithOutput_ReportWithFallback(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.txt")
+	ros := internalreport.ReportersWithOutput{
+		*internalreport.NewReporterWithOutput(countReporter{}, path),
+	}
+	failure := report.Failuref(meta.Position{Filename: "a.proto"}, "RULE", "message")
+
+	for _, test := range []struct {
+		name          string
+		inputFailures []report.Failure
+		wantContent   string
+	}{
+		{
+			name:          "Writes the report to the target file",
+			inputFailures: []report.Failure{failure, failure, failure, failure, failure, failure, failure, failure, failure, failure},
+			wantContent:   "failures=10 files=0\n",
+		},
+		{
+			name:          "Truncates the previous report, which is longer",
+			inputFailures: []report.Failure{failure},
+			wantContent:   "failures=1 files=0\n",
+		},
+	} {
+		err := ros.ReportWithFallback(io.Discard, test.inputFailures)
+		if err != nil {
+			t.Fatalf("%s: got err %v, but want nil", test.name, err)
+		}
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != test.wantContent {
+			t.Errorf("%s: got %q, but want %q", test.name, got, test.wantContent)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package reporters
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// CheckstyleResult is the root element of the Checkstyle XML format.
+type CheckstyleResult struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []CheckstyleFile `xml:"file"`
+}
+
+// CheckstyleFile contains the errors found in a file.
+type CheckstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []CheckstyleError `xml:"error"`
+}
+
+// CheckstyleError is a single failure.
+type CheckstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// CheckstyleReporter prints failures in Checkstyle XML format, grouped per file.
+// A fixed failure is left out, because the format has no way to tell it from an open issue.
+type CheckstyleReporter struct{}
+
+// Report writes failures to w.
+func (r CheckstyleReporter) Report(w io.Writer, fs []report.Failure) error {
+	result := CheckstyleResult{
+		Version: "5.0",
+	}
+	indexes := make(map[string]int)
+	for _, f := range fs {
+		if f.Fixed() {
+			continue
+		}
+		name := f.Pos().Filename
+		i, ok := indexes[name]
+		if !ok {
+			i = len(result.Files)
+			indexes[name] = i
+			result.Files = append(result.Files, CheckstyleFile{Name: name})
+		}
+		result.Files[i].Errors = append(result.Files[i].Errors, CheckstyleError{
+			Line:     f.Pos().Line,
+			Column:   f.Pos().Column,
+			Severity: getCheckstyleSeverity(f.Severity()),
+			Message:  f.Message(),
+			Source:   constructTestCaseName(f.RuleID()),
+		})
+	}
+
+	_, err := w.Write([]byte(xml.Header))
+	if err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	err = enc.Encode(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write([]byte("\n"))
+	return err
+}
+
+func getCheckstyleSeverity(severity string) string {
+	switch rule.Severity(severity) {
+	case rule.SeverityWarning:
+		return "warning"
+	case rule.SeverityNote:
+		return "info"
+	}
+	return "error"
+}
@@ -0,0 +1,102 @@
+package reporters_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+func TestCheckstyleReporter_Report(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		wantOutput    string
+	}{
+		{
+			name: "Prints no failures in the Checkstyle XML format",
+			wantOutput: `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="5.0"></checkstyle>
+`,
+		},
+		{
+			name: "Prints failures grouped per file in the Checkstyle XML format, leaving out the fixed ones",
+			inputFailures: []report.Failure{
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: "other.proto",
+						Offset:   50,
+						Line:     3,
+						Column:   1,
+					},
+					"MAX_LINE_LENGTH",
+					string(rule.SeverityNote),
+					`The line length is 91, but it must be shorter than 80`,
+				),
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   200,
+						Line:     10,
+						Column:   20,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityWarning),
+					`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: "fixed.proto",
+						Offset:   10,
+						Line:     2,
+						Column:   1,
+					},
+					"INDENT",
+					string(rule.SeverityError),
+					`Found an incorrect indentation style "  ". "" is correct.`,
+				).WithFixed(true),
+			},
+			wantOutput: `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="5.0">
+  <file name="example.proto">
+    <error line="5" column="10" severity="error" message="EnumField name &#34;fIRST_VALUE&#34; must be CAPITALS_WITH_UNDERSCORES" source="net.protolint.ENUM_NAMES_UPPER_CAMEL_CASE"></error>
+    <error line="10" column="20" severity="warning" message="EnumField name &#34;SECOND.VALUE&#34; must be CAPITALS_WITH_UNDERSCORES" source="net.protolint.ENUM_NAMES_UPPER_CAMEL_CASE"></error>
+  </file>
+  <file name="other.proto">
+    <error line="3" column="1" severity="info" message="The line length is 91, but it must be shorter than 80" source="net.protolint.MAX_LINE_LENGTH"></error>
+  </file>
+</checkstyle>
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.CheckstyleReporter{}.Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
@@ -68,7 +68,7 @@ func (c CiReporter) Report(w io.Writer, fs []report.Failure) error {
 		return err
 	}
 
-	for _, failure := range fs {
+	for _, failure := range report.Unfixed(fs) {
 		reportedFailure := ciReportedFailure{
 			Severity: getSeverity(failure.Severity()),
 			File:     failure.Pos().Filename,
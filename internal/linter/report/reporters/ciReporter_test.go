@@ -62,6 +62,17 @@ func makeTestData() testCases {
 					string(rule.SeverityError),
 					`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
 				),
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   300,
+						Line:     15,
+						Column:   20,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`EnumField name "third.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				).WithFixed(true),
 			},
 		},
 
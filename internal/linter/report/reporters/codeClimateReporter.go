@@ -0,0 +1,127 @@
+package reporters
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// CodeClimateReporter prints failures as a Code Climate JSON array, which GitLab reads as a Code Quality report.
+// Refer to https://docs.gitlab.com/ee/ci/testing/code_quality.html#implement-a-custom-tool
+// and https://github.com/codeclimate/platform/blob/master/spec/analyzers/SPEC.md#data-types.
+// A fixed failure is left out, because GitLab would show it as an open issue.
+type CodeClimateReporter struct {
+	// categories holds the categories of the rules keyed by the rule ID. It can be nil.
+	categories map[string][]string
+}
+
+// WithRuleInfos returns the reporter which maps the categories of the rules to the Code Climate ones.
+func (r CodeClimateReporter) WithRuleInfos(infos []internalrule.Info) internalreport.Reporter {
+	m := make(map[string][]string)
+	for _, info := range infos {
+		m[info.ID] = info.Categories
+	}
+	return CodeClimateReporter{categories: m}
+}
+
+type codeClimateIssue struct {
+	Type        string              `json:"type"`
+	CheckName   string              `json:"check_name"`
+	Description string              `json:"description"`
+	Categories  []string            `json:"categories"`
+	Fingerprint string              `json:"fingerprint"`
+	Severity    string              `json:"severity"`
+	Location    codeClimateLocation `json:"location"`
+}
+
+type codeClimateLocation struct {
+	Path  string           `json:"path"`
+	Lines codeClimateLines `json:"lines"`
+}
+
+type codeClimateLines struct {
+	Begin int `json:"begin"`
+	End   int `json:"end"`
+}
+
+// Report writes failures to w.
+func (r CodeClimateReporter) Report(w io.Writer, fs []report.Failure) error {
+	unfixed := report.Unfixed(fs)
+
+	issues := []codeClimateIssue{}
+	fps := fingerprints(unfixed)
+	for i, f := range unfixed {
+		line := f.Pos().Line
+		if line < 1 {
+			// A failure about the whole file, like the file name, has no line.
+			line = 1
+		}
+		end := line
+		if e := f.End().Line; line < e {
+			end = e
+		}
+
+		issues = append(issues, codeClimateIssue{
+			Type:        "issue",
+			CheckName:   f.RuleID(),
+			Description: f.Message(),
+			Categories:  r.codeClimateCategories(f.RuleID()),
+			Fingerprint: fps[i],
+			Severity:    getCodeClimateSeverity(f.Severity()),
+			Location: codeClimateLocation{
+				Path: f.Pos().Filename,
+				Lines: codeClimateLines{
+					Begin: line,
+					End:   end,
+				},
+			},
+		})
+	}
+
+	bs, err := json.MarshalIndent(issues, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(bs))
+	return err
+}
+
+// codeClimateCategories maps the categories of the rule to the Code Climate ones, which default to Style.
+func (r CodeClimateReporter) codeClimateCategories(ruleID string) []string {
+	var categories []string
+	seen := make(map[string]bool)
+	for _, c := range r.categories[ruleID] {
+		var category string
+		switch c {
+		case rule.CategoryDocumentation:
+			category = "Clarity"
+		case rule.CategoryCompatibility:
+			category = "Compatibility"
+		default:
+			category = "Style"
+		}
+		if !seen[category] {
+			seen[category] = true
+			categories = append(categories, category)
+		}
+	}
+	if len(categories) == 0 {
+		return []string{"Style"}
+	}
+	return categories
+}
+
+func getCodeClimateSeverity(severity string) string {
+	switch rule.Severity(severity) {
+	case rule.SeverityWarning:
+		return "minor"
+	case rule.SeverityNote:
+		return "info"
+	}
+	return "major"
+}
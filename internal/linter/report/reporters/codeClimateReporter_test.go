@@ -0,0 +1,187 @@
+package reporters_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+type codeClimateIssue struct {
+	Type        string   `json:"type"`
+	CheckName   string   `json:"check_name"`
+	Description string   `json:"description"`
+	Categories  []string `json:"categories"`
+	Fingerprint string   `json:"fingerprint"`
+	Severity    string   `json:"severity"`
+	Location    struct {
+		Path  string `json:"path"`
+		Lines struct {
+			Begin int `json:"begin"`
+			End   int `json:"end"`
+		} `json:"lines"`
+	} `json:"location"`
+}
+
+func codeClimateIssues(t *testing.T, buf *bytes.Buffer) []codeClimateIssue {
+	var issues []codeClimateIssue
+	err := json.Unmarshal(buf.Bytes(), &issues)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	return issues
+}
+
+func TestCodeClimateReporter_Report(t *testing.T) {
+	failures := []report.Failure{
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+				Line:     5,
+				Column:   10,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			string(rule.SeverityWarning),
+			`Enum name "foo" must be UpperCamelCase`,
+		),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+				Line:     8,
+				Column:   3,
+			},
+			"FIELDS_HAVE_COMMENT",
+			string(rule.SeverityError),
+			`Field "bar" should have a comment`,
+		).WithEnd(
+			meta.Position{
+				Filename: "example.proto",
+				Line:     10,
+				Column:   4,
+			},
+		),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+				Line:     9,
+				Column:   3,
+			},
+			"FIELDS_HAVE_COMMENT",
+			string(rule.SeverityError),
+			`Field "bar" should have a comment`,
+		),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "FooBar.proto",
+			},
+			"FILE_NAMES_LOWER_SNAKE_CASE",
+			string(rule.SeverityNote),
+			`File name "FooBar.proto" should be lower_snake_case.proto like "foo_bar.proto".`,
+		),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+				Line:     12,
+				Column:   1,
+			},
+			"INDENT",
+			string(rule.SeverityError),
+			`Found an incorrect indentation style "  ". "" is correct.`,
+		).WithFixed(true),
+	}
+	infos := []internalrule.Info{
+		{
+			ID:         "FIELDS_HAVE_COMMENT",
+			Categories: []string{rule.CategoryDocumentation, rule.CategoryStyle},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	err := reporters.CodeClimateReporter{}.WithRuleInfos(infos).Report(buf, failures)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	issues := codeClimateIssues(t, buf)
+	if len(issues) != 4 {
+		t.Fatalf("got %d issues, but want 4", len(issues))
+	}
+
+	for i, want := range []struct {
+		checkName  string
+		categories []string
+		severity   string
+		path       string
+		begin      int
+		end        int
+	}{
+		{"ENUM_NAMES_UPPER_CAMEL_CASE", []string{"Style"}, "minor", "example.proto", 5, 5},
+		{"FIELDS_HAVE_COMMENT", []string{"Clarity", "Style"}, "major", "example.proto", 8, 10},
+		{"FIELDS_HAVE_COMMENT", []string{"Clarity", "Style"}, "major", "example.proto", 9, 9},
+		{"FILE_NAMES_LOWER_SNAKE_CASE", []string{"Style"}, "info", "FooBar.proto", 1, 1},
+	} {
+		got := issues[i]
+		if got.Type != "issue" || got.CheckName != want.checkName || got.Severity != want.severity ||
+			!reflect.DeepEqual(got.Categories, want.categories) ||
+			got.Location.Path != want.path || got.Location.Lines.Begin != want.begin || got.Location.Lines.End != want.end {
+			t.Errorf("got %+v, but want %+v", got, want)
+		}
+		if got.Description != failures[i].Message() {
+			t.Errorf("got the description %q, but want %q", got.Description, failures[i].Message())
+		}
+	}
+
+	fingerprints := make(map[string]bool)
+	for _, issue := range issues {
+		fingerprints[issue.Fingerprint] = true
+	}
+	if len(fingerprints) != 4 {
+		t.Errorf("got %d unique fingerprints, but want 4", len(fingerprints))
+	}
+}
+
+func TestCodeClimateReporter_ReportStableFingerprint(t *testing.T) {
+	failureAt := func(line int) []report.Failure {
+		return []report.Failure{
+			report.Failuref(
+				meta.Position{
+					Filename: "example.proto",
+					Line:     line,
+					Column:   1,
+				},
+				"MAX_LINE_LENGTH",
+				"The line length is 91, but it must be shorter than 80",
+			),
+		}
+	}
+
+	var fingerprints []string
+	for _, line := range []int{3, 30} {
+		buf := &bytes.Buffer{}
+		err := reporters.CodeClimateReporter{}.Report(buf, failureAt(line))
+		if err != nil {
+			t.Fatalf("got err %v, but want nil", err)
+		}
+		fingerprints = append(fingerprints, codeClimateIssues(t, buf)[0].Fingerprint)
+	}
+	if fingerprints[0] != fingerprints[1] {
+		t.Errorf("got the fingerprints %v changed by the line, but want the same one", fingerprints)
+	}
+}
+
+func TestCodeClimateReporter_ReportNoFailures(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := reporters.CodeClimateReporter{}.Report(buf, nil)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	if buf.String() != "[]\n" {
+		t.Errorf("got %q, but want an empty array", buf.String())
+	}
+}
@@ -0,0 +1,24 @@
+package reporters
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+// fingerprints returns the fingerprints of the failures, which identify them across runs.
+// A fingerprint leaves out the line, so that a failure keeps it when the code around it moves.
+// The same failures in a file are told apart by the order of their appearance.
+func fingerprints(fs []report.Failure) []string {
+	var fps []string
+	occurrences := make(map[string]int)
+	for _, f := range fs {
+		key := fmt.Sprintf("%s\x00%s\x00%s", f.RuleID(), f.Pos().Filename, f.Message())
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%d", key, occurrences[key])))
+		occurrences[key]++
+		fps = append(fps, hex.EncodeToString(sum[:]))
+	}
+	return fps
+}
@@ -0,0 +1,281 @@
+package reporters
+
+import (
+	"html/template"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// htmlSnippetContext is the number of the lines shown before and after the offending line.
+const htmlSnippetContext = 2
+
+// HTMLReporter prints failures as a self-contained HTML page, which can be shared as an artifact.
+// The page summarizes the failures per rule and per file, allows to filter them by severity and rule,
+// and shows the offending source lines read from the files.
+type HTMLReporter struct {
+	// readSource reads the sources. It's nil to read them from disk.
+	readSource internalreport.SourceReader
+}
+
+// WithSources returns the reporter which shows the source lines read with read.
+func (r HTMLReporter) WithSources(read internalreport.SourceReader) internalreport.Reporter {
+	r.readSource = read
+	return r
+}
+
+type htmlReport struct {
+	Total      int
+	Fixed      int
+	Severities []string
+	Rules      []severitySummary
+	Files      []severitySummary
+	Findings   []htmlFinding
+}
+
+// severitySummary counts the failures of a rule or a file by severity.
+type severitySummary struct {
+	Name    string
+	Error   int
+	Warning int
+	Note    int
+	Total   int
+}
+
+func (s *severitySummary) add(severity string) {
+	switch severity {
+	case string(rule.SeverityWarning):
+		s.Warning++
+	case string(rule.SeverityNote):
+		s.Note++
+	default:
+		s.Error++
+	}
+	s.Total++
+}
+
+type htmlFinding struct {
+	RuleID   string
+	Severity string
+	Message  string
+	Path     string
+	Line     int
+	Column   int
+	Fixed    bool
+	Snippet  []htmlSnippetLine
+}
+
+// htmlSnippetLine is a source line. The offending one is split around the character at the column.
+type htmlSnippetLine struct {
+	Number    int
+	Text      string
+	Offending bool
+	Before    string
+	Marked    string
+	After     string
+}
+
+// Report writes failures to w.
+func (r HTMLReporter) Report(w io.Writer, fs []report.Failure) error {
+	data := htmlReport{
+		Total: len(fs),
+		Fixed: report.CountFixed(fs),
+	}
+	rules := make(map[string]*severitySummary)
+	files := make(map[string]*severitySummary)
+	severities := make(map[string]bool)
+	sources := make(map[string][]string)
+	for _, f := range fs {
+		severity := normalizedSeverity(f.Severity())
+		severities[severity] = true
+		summaryOf(rules, f.RuleID()).add(severity)
+		summaryOf(files, f.Pos().Filename).add(severity)
+
+		lines, ok := sources[f.Pos().Filename]
+		if !ok {
+			lines = readSourceLines(r.readSource, f.Pos().Filename)
+			sources[f.Pos().Filename] = lines
+		}
+		data.Findings = append(data.Findings, htmlFinding{
+			RuleID:   f.RuleID(),
+			Severity: severity,
+			Message:  f.Message(),
+			Path:     f.Pos().Filename,
+			Line:     f.Pos().Line,
+			Column:   f.Pos().Column,
+			Fixed:    f.Fixed(),
+			Snippet:  newHTMLSnippet(lines, f.Pos().Line, f.Pos().Column),
+		})
+	}
+	for _, s := range []rule.Severity{rule.SeverityError, rule.SeverityWarning, rule.SeverityNote} {
+		if severities[string(s)] {
+			data.Severities = append(data.Severities, string(s))
+		}
+	}
+	data.Rules = sortedSummaries(rules)
+	data.Files = sortedSummaries(files)
+	return htmlTemplate.Execute(w, data)
+}
+
+// normalizedSeverity returns the severity, which defaults to error if it's unknown.
+func normalizedSeverity(severity string) string {
+	switch rule.Severity(severity) {
+	case rule.SeverityWarning, rule.SeverityNote:
+		return severity
+	}
+	return string(rule.SeverityError)
+}
+
+func summaryOf(summaries map[string]*severitySummary, name string) *severitySummary {
+	s, ok := summaries[name]
+	if !ok {
+		s = &severitySummary{Name: name}
+		summaries[name] = s
+	}
+	return s
+}
+
+// sortedSummaries sorts the summaries with the most failures first.
+func sortedSummaries(summaries map[string]*severitySummary) []severitySummary {
+	var sorted []severitySummary
+	for _, s := range summaries {
+		sorted = append(sorted, *s)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Total != sorted[j].Total {
+			return sorted[i].Total > sorted[j].Total
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+// readSourceLines reads the file with read, or from disk if read is nil.
+// It returns nil if the file can't be read, in which case the findings have no snippets.
+func readSourceLines(read internalreport.SourceReader, path string) []string {
+	if read == nil {
+		read = os.ReadFile
+	}
+	content, err := read(path)
+	if err != nil {
+		return nil
+	}
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// newHTMLSnippet returns the lines around the line. A failure about the whole file, which has no line, has no snippet.
+func newHTMLSnippet(lines []string, line, column int) []htmlSnippetLine {
+	if line < 1 || len(lines) < line {
+		return nil
+	}
+	var snippet []htmlSnippetLine
+	for n := max(1, line-htmlSnippetContext); n <= min(len(lines), line+htmlSnippetContext); n++ {
+		l := htmlSnippetLine{
+			Number: n,
+			Text:   lines[n-1],
+		}
+		if n == line {
+			l.Offending = true
+			// The column counts the characters from 1.
+			text := []rune(l.Text)
+			c := min(max(column, 1), len(text)+1) - 1
+			l.Before = string(text[:c])
+			if c < len(text) {
+				l.Marked = string(text[c])
+				l.After = string(text[c+1:])
+			}
+		}
+		snippet = append(snippet, l)
+	}
+	return snippet
+}
+
+var htmlTemplate = template.Must(template.New("html").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>protolint report</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #24292f; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #d0d7de; padding: 4px 10px; text-align: left; }
+td.count { text-align: right; }
+.filters { margin-bottom: 1em; }
+.finding { border: 1px solid #d0d7de; border-radius: 6px; margin-bottom: 1em; padding: 0.5em 1em; }
+.severity { font-weight: bold; text-transform: uppercase; }
+.severity-error { color: #cf222e; }
+.severity-warning { color: #9a6700; }
+.severity-note { color: #0969da; }
+.fixed { color: #1a7f37; font-weight: bold; }
+pre { background: #f6f8fa; padding: 0.5em; overflow-x: auto; }
+.line-number { color: #8c959f; display: inline-block; min-width: 4em; user-select: none; }
+.offending { background: #fff8c5; }
+mark { background: #ff8182; }
+</style>
+</head>
+<body>
+<h1>protolint report</h1>
+<p>{{.Total}} problems{{if .Fixed}}, {{.Fixed}} fixed{{end}}.</p>
+{{- if .Findings}}
+<h2>Rules</h2>
+<table>
+<tr><th>Rule</th><th>Error</th><th>Warning</th><th>Note</th><th>Total</th></tr>
+{{- range .Rules}}
+<tr><td>{{.Name}}</td><td class="count">{{.Error}}</td><td class="count">{{.Warning}}</td><td class="count">{{.Note}}</td><td class="count">{{.Total}}</td></tr>
+{{- end}}
+</table>
+<h2>Files</h2>
+<table>
+<tr><th>File</th><th>Error</th><th>Warning</th><th>Note</th><th>Total</th></tr>
+{{- range .Files}}
+<tr><td>{{.Name}}</td><td class="count">{{.Error}}</td><td class="count">{{.Warning}}</td><td class="count">{{.Note}}</td><td class="count">{{.Total}}</td></tr>
+{{- end}}
+</table>
+<h2>Problems</h2>
+<div class="filters">
+<label>Severity <select id="severity-filter" onchange="filterFindings()">
+<option value="">all</option>
+{{- range .Severities}}
+<option value="{{.}}">{{.}}</option>
+{{- end}}
+</select></label>
+<label>Rule <select id="rule-filter" onchange="filterFindings()">
+<option value="">all</option>
+{{- range .Rules}}
+<option value="{{.Name}}">{{.Name}}</option>
+{{- end}}
+</select></label>
+</div>
+{{- range .Findings}}
+<div class="finding" data-severity="{{.Severity}}" data-rule="{{.RuleID}}">
+<p><span class="severity severity-{{.Severity}}">{{.Severity}}</span> {{.Path}}{{if .Line}}:{{.Line}}:{{.Column}}{{end}} {{.RuleID}}{{if .Fixed}} <span class="fixed">fixed</span>{{end}}</p>
+<p>{{.Message}}</p>
+{{- if .Snippet}}
+<pre>
+{{- range .Snippet}}
+{{if .Offending}}<span class="offending"><span class="line-number">{{.Number}}</span>{{.Before}}<mark>{{.Marked}}</mark>{{.After}}</span>{{else}}<span class="line-number">{{.Number}}</span>{{.Text}}{{end}}
+{{- end}}
+</pre>
+{{- end}}
+</div>
+{{- end}}
+<script>
+function filterFindings() {
+  var severity = document.getElementById("severity-filter").value;
+  var rule = document.getElementById("rule-filter").value;
+  document.querySelectorAll(".finding").forEach(function (finding) {
+    var shown = (!severity || finding.dataset.severity === severity) && (!rule || finding.dataset.rule === rule);
+    finding.style.display = shown ? "" : "none";
+  });
+}
+</script>
+{{- end}}
+</body>
+</html>
+`))
@@ -0,0 +1,148 @@
+package reporters_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+func TestHTMLReporter_Report(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "example.proto")
+	err := os.WriteFile(path, []byte(`syntax = "proto3";
+
+enum enumName {
+  fIRST_VALUE = 0;
+}
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		wantContains  []string
+		wantMissing   []string
+	}{
+		{
+			name: "Prints the summaries and the findings with the snippets",
+			inputFailures: []report.Failure{
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: path,
+						Line:     3,
+						Column:   1,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`Enum name "enumName" must be UpperCamelCase like "EnumName"`,
+				),
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: path,
+						Line:     4,
+						Column:   3,
+					},
+					"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+					string(rule.SeverityWarning),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				).WithFixed(true),
+				report.Failuref(
+					meta.Position{
+						Filename: "missing.proto",
+					},
+					"FILE_HAS_COMMENT",
+					"File should have a comment",
+				),
+			},
+			wantContains: []string{
+				"<p>3 problems, 1 fixed.</p>",
+				`<tr><td>ENUM_NAMES_UPPER_CAMEL_CASE</td><td class="count">1</td><td class="count">0</td><td class="count">0</td><td class="count">1</td></tr>`,
+				`<tr><td>` + path + `</td><td class="count">1</td><td class="count">1</td><td class="count">0</td><td class="count">2</td></tr>`,
+				`<option value="warning">warning</option>`,
+				`<div class="finding" data-severity="warning" data-rule="ENUM_FIELD_NAMES_UPPER_SNAKE_CASE">`,
+				`<span class="fixed">fixed</span>`,
+				`Enum name &#34;enumName&#34; must be UpperCamelCase like &#34;EnumName&#34;`,
+				`<span class="line-number">1</span>syntax = &#34;proto3&#34;;`,
+				`<span class="offending"><span class="line-number">3</span><mark>e</mark>num enumName {</span>`,
+				`<span class="offending"><span class="line-number">4</span>  <mark>f</mark>IRST_VALUE = 0;</span>`,
+				`<span class="line-number">5</span>}`,
+				`<div class="finding" data-severity="error" data-rule="FILE_HAS_COMMENT">`,
+			},
+			wantMissing: []string{
+				`<option value="note">note</option>`,
+				`<span class="line-number">6</span>`,
+			},
+		},
+		{
+			name: "Prints no tables without failures",
+			wantContains: []string{
+				"<p>0 problems.</p>",
+			},
+			wantMissing: []string{
+				"<table>",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.HTMLReporter{}.Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			got := buf.String()
+			for _, want := range test.wantContains {
+				if !strings.Contains(got, want) {
+					t.Errorf("got %s, but want it to contain %s", got, want)
+				}
+			}
+			for _, want := range test.wantMissing {
+				if strings.Contains(got, want) {
+					t.Errorf("got %s, but want it not to contain %s", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestHTMLReporter_ReportWithSources(t *testing.T) {
+	read := func(path string) ([]byte, error) {
+		if path != "stdin.proto" {
+			return nil, os.ErrNotExist
+		}
+		return []byte("syntax = \"proto3\";\nenum enumName {}\n"), nil
+	}
+	failures := []report.Failure{
+		report.Failuref(
+			meta.Position{
+				Filename: "stdin.proto",
+				Line:     2,
+				Column:   6,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			`Enum name "enumName" must be UpperCamelCase like "EnumName"`,
+		),
+	}
+
+	buf := &bytes.Buffer{}
+	err := reporters.HTMLReporter{}.WithSources(read).Report(buf, failures)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	want := `<span class="offending"><span class="line-number">2</span>enum <mark>e</mark>numName {}</span>`
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("got %s, but want it to contain %s", buf.String(), want)
+	}
+}
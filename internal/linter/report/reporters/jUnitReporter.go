@@ -59,6 +59,7 @@ type JUnitReporter struct{}
 
 // Report writes failures to w.
 func (r JUnitReporter) Report(w io.Writer, fs []report.Failure) error {
+	fs = report.Unfixed(fs)
 	suites := &JUnitTestSuites{}
 	if 0 < len(fs) {
 		var testcases []JUnitTestCase
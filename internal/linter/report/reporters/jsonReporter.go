@@ -16,9 +16,12 @@ import (
 //	 {
 //			"lints":
 //				[
-//					{"filename": FILENAME, "line": LINE, "column": COL, "message": MESSAGE, "rule": RULE}
+//					{"filename": FILENAME, "line": LINE, "column": COL, "message": MESSAGE, "rule": RULE, "fixed": FIXED}
 //				],
+//			"fixed_count": FIXED_COUNT,
 //	 }
+//
+// "fixed" and "fixed_count" are omitted unless some failures are fixed.
 type JSONReporter struct{}
 
 type lintJSON struct {
@@ -27,10 +30,12 @@ type lintJSON struct {
 	Column   int    `json:"column"`
 	Message  string `json:"message"`
 	Rule     string `json:"rule"`
+	Fixed    bool   `json:"fixed,omitempty"`
 }
 
 type outJSON struct {
-	Lints []lintJSON `json:"lints"`
+	Lints      []lintJSON `json:"lints"`
+	FixedCount int        `json:"fixed_count,omitempty"`
 }
 
 // Report writes failures to w.
@@ -43,8 +48,10 @@ func (r JSONReporter) Report(w io.Writer, fs []report.Failure) error {
 			Column:   failure.Pos().Column,
 			Message:  failure.Message(),
 			Rule:     failure.RuleID(),
+			Fixed:    failure.Fixed(),
 		})
 	}
+	out.FixedCount = report.CountFixed(fs)
 
 	bs, err := json.MarshalIndent(out, "", "  ")
 	if err != nil {
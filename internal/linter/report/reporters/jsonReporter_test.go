@@ -58,6 +58,52 @@ func TestJSONReporter_Report(t *testing.T) {
     }
   ]
 }
+`,
+		},
+		{
+			name: "Prints fixed failures with the count",
+			inputFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				).WithFixed(true),
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   200,
+						Line:     10,
+						Column:   20,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+			},
+			wantOutput: `{
+  "lints": [
+    {
+      "filename": "example.proto",
+      "line": 5,
+      "column": 10,
+      "message": "EnumField name \"fIRST_VALUE\" must be CAPITALS_WITH_UNDERSCORES",
+      "rule": "ENUM_NAMES_UPPER_CAMEL_CASE",
+      "fixed": true
+    },
+    {
+      "filename": "example.proto",
+      "line": 10,
+      "column": 20,
+      "message": "EnumField name \"SECOND.VALUE\" must be CAPITALS_WITH_UNDERSCORES",
+      "rule": "ENUM_NAMES_UPPER_CAMEL_CASE"
+    }
+  ],
+  "fixed_count": 1
+}
 `,
 		},
 	}
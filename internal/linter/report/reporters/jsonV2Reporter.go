@@ -0,0 +1,224 @@
+package reporters
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+const (
+	// JSONV2SchemaVersion is the version of the format of JSONV2Reporter.
+	// It's bumped on a breaking change of the format, which adding a property isn't.
+	JSONV2SchemaVersion = "2.0"
+	// JSONV2SchemaURL is the URL of the JSON Schema of the format of JSONV2Reporter.
+	JSONV2SchemaURL = "https://raw.githubusercontent.com/yoheimuta/protolint/master/_schema/json-v2.schema.json"
+)
+
+// JSONV2Reporter prints the failures and the lint run as a versioned JSON document.
+// Unlike JSONReporter, it includes the severities, the categories, the ranges and the fixes of the failures,
+// the status of each file and the summary counts.
+// The format is described by the JSON Schema at JSONV2SchemaURL.
+type JSONV2Reporter struct {
+	// infos holds the infos of the rules keyed by the rule ID. It can be nil.
+	infos map[string]internalrule.Info
+	run   internalreport.RunInfo
+}
+
+// WithRuleInfos returns the reporter which fills the categories and the fixability with the infos.
+func (r JSONV2Reporter) WithRuleInfos(infos []internalrule.Info) internalreport.Reporter {
+	m := make(map[string]internalrule.Info)
+	for _, info := range infos {
+		m[info.ID] = info
+	}
+	r.infos = m
+	return r
+}
+
+// WithRunInfo returns the reporter which describes the tool, the config file and the files with the info.
+func (r JSONV2Reporter) WithRunInfo(info internalreport.RunInfo) internalreport.Reporter {
+	r.run = info
+	return r
+}
+
+type jsonV2Report struct {
+	Schema        string          `json:"$schema"`
+	SchemaVersion string          `json:"schema_version"`
+	Tool          jsonV2Tool      `json:"tool"`
+	Config        jsonV2Config    `json:"config"`
+	Files         []jsonV2File    `json:"files"`
+	Failures      []jsonV2Failure `json:"failures"`
+	Summary       jsonV2Summary   `json:"summary"`
+}
+
+type jsonV2Tool struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type jsonV2Config struct {
+	// Path is nil when no config file is loaded.
+	Path *string `json:"path"`
+}
+
+type jsonV2File struct {
+	Path   string `json:"path"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type jsonV2Failure struct {
+	RuleID   string `json:"rule_id"`
+	Severity string `json:"severity"`
+	// Categories is empty when the rule has no category or isn't known.
+	Categories  []string    `json:"categories"`
+	Message     string      `json:"message"`
+	File        string      `json:"file"`
+	Range       jsonV2Range `json:"range"`
+	Fix         jsonV2Fix   `json:"fix"`
+	Fingerprint string      `json:"fingerprint"`
+}
+
+type jsonV2Range struct {
+	Start jsonV2Position  `json:"start"`
+	End   *jsonV2Position `json:"end"`
+}
+
+type jsonV2Position struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	Offset int `json:"offset"`
+}
+
+type jsonV2Fix struct {
+	// Available is whether the rule can fix the failure with -fix.
+	Available bool `json:"available"`
+	// Applied is whether the failure is fixed in this run.
+	Applied bool `json:"applied"`
+}
+
+type jsonV2Summary struct {
+	Files    jsonV2FileCounts    `json:"files"`
+	Failures jsonV2FailureCounts `json:"failures"`
+}
+
+type jsonV2FileCounts struct {
+	Total      int `json:"total"`
+	Linted     int `json:"linted"`
+	Skipped    int `json:"skipped"`
+	ParseError int `json:"parse_error"`
+	Error      int `json:"error"`
+}
+
+type jsonV2FailureCounts struct {
+	Total   int `json:"total"`
+	Error   int `json:"error"`
+	Warning int `json:"warning"`
+	Note    int `json:"note"`
+	Fixed   int `json:"fixed"`
+	Fixable int `json:"fixable"`
+}
+
+// Report writes failures to w.
+func (r JSONV2Reporter) Report(w io.Writer, fs []report.Failure) error {
+	out := jsonV2Report{
+		Schema:        JSONV2SchemaURL,
+		SchemaVersion: JSONV2SchemaVersion,
+		Tool: jsonV2Tool{
+			Name:    "protolint",
+			Version: r.run.ToolVersion,
+		},
+		Files:    []jsonV2File{},
+		Failures: []jsonV2Failure{},
+	}
+	if r.run.ConfigPath != "" {
+		path := r.run.ConfigPath
+		out.Config.Path = &path
+	}
+
+	for _, file := range r.run.Files {
+		out.Files = append(out.Files, jsonV2File{
+			Path:   file.Path,
+			Status: string(file.Status),
+			Error:  file.Error,
+		})
+		counts := &out.Summary.Files
+		counts.Total++
+		switch file.Status {
+		case internalreport.FileStatusLinted:
+			counts.Linted++
+		case internalreport.FileStatusSkipped:
+			counts.Skipped++
+		case internalreport.FileStatusParseError:
+			counts.ParseError++
+		default:
+			counts.Error++
+		}
+	}
+
+	fps := fingerprints(fs)
+	for i, f := range fs {
+		severity := normalizedSeverity(f.Severity())
+		info, known := r.infos[f.RuleID()]
+		categories := []string{}
+		if known && 0 < len(info.Categories) {
+			categories = info.Categories
+		}
+		pos := f.Pos()
+		var end *jsonV2Position
+		if e := f.End(); 0 < e.Line {
+			end = &jsonV2Position{
+				Line:   e.Line,
+				Column: e.Column,
+				Offset: e.Offset,
+			}
+		}
+		out.Failures = append(out.Failures, jsonV2Failure{
+			RuleID:     f.RuleID(),
+			Severity:   severity,
+			Categories: categories,
+			Message:    f.Message(),
+			File:       pos.Filename,
+			Range: jsonV2Range{
+				Start: jsonV2Position{
+					Line:   pos.Line,
+					Column: pos.Column,
+					Offset: pos.Offset,
+				},
+				End: end,
+			},
+			Fix: jsonV2Fix{
+				Available: info.Fixable,
+				Applied:   f.Fixed(),
+			},
+			Fingerprint: fps[i],
+		})
+
+		counts := &out.Summary.Failures
+		counts.Total++
+		switch rule.Severity(severity) {
+		case rule.SeverityWarning:
+			counts.Warning++
+		case rule.SeverityNote:
+			counts.Note++
+		default:
+			counts.Error++
+		}
+		if f.Fixed() {
+			counts.Fixed++
+		} else if info.Fixable {
+			counts.Fixable++
+		}
+	}
+
+	bs, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(bs))
+	return err
+}
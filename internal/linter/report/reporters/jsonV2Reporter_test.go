@@ -0,0 +1,250 @@
+package reporters_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+func TestJSONV2Reporter_Report(t *testing.T) {
+	infos := []internalrule.Info{
+		{ID: "ENUM_NAMES_UPPER_CAMEL_CASE", Categories: []string{rule.CategoryNaming, rule.CategoryStyle}, Fixable: true},
+		{ID: "FILE_HAS_COMMENT"},
+	}
+	runInfo := internalreport.RunInfo{
+		ToolVersion: "0.50.0(abcdef)",
+		ConfigPath:  ".protolint.yaml",
+		Files: []internalreport.FileResult{
+			{Path: "example.proto", Status: internalreport.FileStatusLinted},
+			{Path: "vendor/skipped.proto", Status: internalreport.FileStatusSkipped},
+			{Path: "broken.proto", Status: internalreport.FileStatusParseError, Error: "found \"}\" but expected [;]"},
+		},
+	}
+	failures := []report.Failure{
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+				Offset:   20,
+				Line:     3,
+				Column:   6,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			string(rule.SeverityError),
+			`Enum name "enumName" must be UpperCamelCase like "EnumName"`,
+		).WithEnd(
+			meta.Position{
+				Filename: "example.proto",
+				Offset:   28,
+				Line:     3,
+				Column:   14,
+			},
+		),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+				Offset:   20,
+				Line:     3,
+				Column:   6,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			string(rule.SeverityError),
+			`Enum name "otherName" must be UpperCamelCase like "OtherName"`,
+		).WithFixed(true),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+			},
+			"FILE_HAS_COMMENT",
+			string(rule.SeverityNote),
+			"File should have a comment",
+		),
+	}
+
+	tests := []struct {
+		name          string
+		inputReporter internalreport.Reporter
+		inputFailures []report.Failure
+		wantOutput    string
+	}{
+		{
+			name: "Prints the run and the failures with the rule infos",
+			inputReporter: reporters.JSONV2Reporter{}.
+				WithRuleInfos(infos).(internalreport.RunInfoReporter).
+				WithRunInfo(runInfo),
+			inputFailures: failures,
+			wantOutput: `{
+  "$schema": "https://raw.githubusercontent.com/yoheimuta/protolint/master/_schema/json-v2.schema.json",
+  "schema_version": "2.0",
+  "tool": {
+    "name": "protolint",
+    "version": "0.50.0(abcdef)"
+  },
+  "config": {
+    "path": ".protolint.yaml"
+  },
+  "files": [
+    {
+      "path": "example.proto",
+      "status": "linted"
+    },
+    {
+      "path": "vendor/skipped.proto",
+      "status": "skipped"
+    },
+    {
+      "path": "broken.proto",
+      "status": "parse_error",
+      "error": "found \"}\" but expected [;]"
+    }
+  ],
+  "failures": [
+    {
+      "rule_id": "ENUM_NAMES_UPPER_CAMEL_CASE",
+      "severity": "error",
+      "categories": [
+        "naming",
+        "style"
+      ],
+      "message": "Enum name \"enumName\" must be UpperCamelCase like \"EnumName\"",
+      "file": "example.proto",
+      "range": {
+        "start": {
+          "line": 3,
+          "column": 6,
+          "offset": 20
+        },
+        "end": {
+          "line": 3,
+          "column": 14,
+          "offset": 28
+        }
+      },
+      "fix": {
+        "available": true,
+        "applied": false
+      },
+      "fingerprint": "262a001902e7486ae20a8479e0026a911ad73524d4e2916916d4e8bfa6aeb432"
+    },
+    {
+      "rule_id": "ENUM_NAMES_UPPER_CAMEL_CASE",
+      "severity": "error",
+      "categories": [
+        "naming",
+        "style"
+      ],
+      "message": "Enum name \"otherName\" must be UpperCamelCase like \"OtherName\"",
+      "file": "example.proto",
+      "range": {
+        "start": {
+          "line": 3,
+          "column": 6,
+          "offset": 20
+        },
+        "end": null
+      },
+      "fix": {
+        "available": true,
+        "applied": true
+      },
+      "fingerprint": "93a3dcd0374a3cfa60ae42657bc14ff9b57df08b4aa3457d4dde45d7ded7b16c"
+    },
+    {
+      "rule_id": "FILE_HAS_COMMENT",
+      "severity": "note",
+      "categories": [],
+      "message": "File should have a comment",
+      "file": "example.proto",
+      "range": {
+        "start": {
+          "line": 0,
+          "column": 0,
+          "offset": 0
+        },
+        "end": null
+      },
+      "fix": {
+        "available": false,
+        "applied": false
+      },
+      "fingerprint": "2d62fba2afa0a4accd5efa0c5fb920ffdfd433284f0f9ddc680f237652c258f3"
+    }
+  ],
+  "summary": {
+    "files": {
+      "total": 3,
+      "linted": 1,
+      "skipped": 1,
+      "parse_error": 1,
+      "error": 0
+    },
+    "failures": {
+      "total": 3,
+      "error": 2,
+      "warning": 0,
+      "note": 1,
+      "fixed": 1,
+      "fixable": 1
+    }
+  }
+}
+`,
+		},
+		{
+			name:          "Prints empty arrays and null without the run info and failures",
+			inputReporter: reporters.JSONV2Reporter{},
+			wantOutput: `{
+  "$schema": "https://raw.githubusercontent.com/yoheimuta/protolint/master/_schema/json-v2.schema.json",
+  "schema_version": "2.0",
+  "tool": {
+    "name": "protolint",
+    "version": ""
+  },
+  "config": {
+    "path": null
+  },
+  "files": [],
+  "failures": [],
+  "summary": {
+    "files": {
+      "total": 0,
+      "linted": 0,
+      "skipped": 0,
+      "parse_error": 0,
+      "error": 0
+    },
+    "failures": {
+      "total": 0,
+      "error": 0,
+      "warning": 0,
+      "note": 0,
+      "fixed": 0,
+      "fixable": 0
+    }
+  }
+}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := test.inputReporter.Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package reporters
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/yoheimuta/protolint/internal/linter/config"
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+const (
+	defaultMarkdownMaxFindings = 100
+	// defaultMarkdownMaxBytes keeps the output under the limit of a GitHub comment, 65536 characters.
+	defaultMarkdownMaxBytes = 60000
+)
+
+// MarkdownReporter prints failures in Markdown to be posted as a pull-request comment.
+// It prints a collapsible summary table of the counts by rule and severity, and the findings per file.
+// The findings are truncated with a note of the rest so that the comment stays under the size limit.
+type MarkdownReporter struct {
+	option config.MarkdownReporterOption
+}
+
+// WithMarkdownOption returns the reporter which links the findings and truncates them as the option.
+func (r MarkdownReporter) WithMarkdownOption(option config.MarkdownReporterOption) internalreport.Reporter {
+	return MarkdownReporter{option: option}
+}
+
+// Report writes failures to w.
+func (r MarkdownReporter) Report(w io.Writer, fs []report.Failure) error {
+	var b strings.Builder
+	b.WriteString("## protolint\n\n")
+	if len(fs) == 0 {
+		b.WriteString("No problems found.\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	var files []string
+	byFile := make(map[string][]report.Failure)
+	rules := make(map[string]*severitySummary)
+	total := severitySummary{}
+	for _, f := range fs {
+		name := f.Pos().Filename
+		if _, ok := byFile[name]; !ok {
+			files = append(files, name)
+		}
+		byFile[name] = append(byFile[name], f)
+		severity := normalizedSeverity(f.Severity())
+		summaryOf(rules, f.RuleID()).add(severity)
+		total.add(severity)
+	}
+
+	var counts []string
+	for _, c := range []struct {
+		n    int
+		noun string
+	}{
+		{total.Error, "error"},
+		{total.Warning, "warning"},
+		{total.Note, "note"},
+	} {
+		if 0 < c.n {
+			counts = append(counts, plural(c.n, c.noun))
+		}
+	}
+	fmt.Fprintf(&b, "**%s** (%s) in %s", plural(len(fs), "problem"), strings.Join(counts, ", "), plural(len(files), "file"))
+	if fixed := report.CountFixed(fs); 0 < fixed {
+		fmt.Fprintf(&b, ", %d fixed", fixed)
+	}
+	b.WriteString(".\n\n")
+
+	b.WriteString("<details>\n<summary>Problems by rule</summary>\n\n")
+	b.WriteString("| Rule | Errors | Warnings | Notes | Total |\n")
+	b.WriteString("| --- | ---: | ---: | ---: | ---: |\n")
+	for _, s := range sortedSummaries(rules) {
+		fmt.Fprintf(&b, "| `%s` | %d | %d | %d | %d |\n", s.Name, s.Error, s.Warning, s.Note, s.Total)
+	}
+	b.WriteString("\n</details>\n")
+
+	maxFindings := r.option.MaxFindings
+	if maxFindings <= 0 {
+		maxFindings = defaultMarkdownMaxFindings
+	}
+	maxBytes := r.option.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultMarkdownMaxBytes
+	}
+	// truncationNote is reserved in the budget to note the rest.
+	truncationNote := fmt.Sprintf("\n_...and %d more._\n", len(fs))
+
+	shown := 0
+findings:
+	for _, name := range files {
+		heading := fmt.Sprintf("\n### `%s`\n\n", name)
+		for i, f := range byFile[name] {
+			line := r.markdownFinding(f)
+			if i == 0 {
+				line = heading + line
+			}
+			if shown == maxFindings || maxBytes < b.Len()+len(line)+len(truncationNote) {
+				break findings
+			}
+			b.WriteString(line)
+			shown++
+		}
+	}
+	if shown < len(fs) {
+		fmt.Fprintf(&b, "\n_...and %d more._\n", len(fs)-shown)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// markdownFinding returns the list item of the failure, which links to the line if the base URL is given.
+func (r MarkdownReporter) markdownFinding(f report.Failure) string {
+	pos := f.Pos()
+	location := pos.Filename
+	if 0 < pos.Line {
+		location = fmt.Sprintf("%s:%d:%d", pos.Filename, pos.Line, pos.Column)
+	}
+	location = escapeMarkdown(location)
+	if link := r.markdownLink(pos.Filename, pos.Line); link != "" {
+		location = fmt.Sprintf("[%s](%s)", location, link)
+	}
+
+	item := fmt.Sprintf("- %s %s **%s** %s", markdownSeverityIcon(f.Severity()), location, f.RuleID(), escapeMarkdown(f.Message()))
+	if f.Fixed() {
+		item += " _(fixed)_"
+	}
+	return item + "\n"
+}
+
+// markdownLink returns the URL of the file#Lline, or an empty string without the base URL.
+// An absolute path isn't linked because it isn't relative to the base.
+func (r MarkdownReporter) markdownLink(path string, line int) string {
+	if r.option.BaseURL == "" || filepath.IsAbs(path) {
+		return ""
+	}
+	var segments []string
+	for _, s := range strings.Split(filepath.ToSlash(filepath.Clean(path)), "/") {
+		segments = append(segments, url.PathEscape(s))
+	}
+	link := strings.TrimSuffix(r.option.BaseURL, "/") + "/" + strings.Join(segments, "/")
+	if 0 < line {
+		link += fmt.Sprintf("#L%d", line)
+	}
+	return link
+}
+
+func markdownSeverityIcon(severity string) string {
+	switch rule.Severity(normalizedSeverity(severity)) {
+	case rule.SeverityWarning:
+		return ":warning:"
+	case rule.SeverityNote:
+		return ":information_source:"
+	}
+	return ":x:"
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`>`, `\>`,
+	`|`, `\|`,
+	"\n", " ",
+)
+
+// escapeMarkdown escapes the characters which Markdown interprets, and puts the text on a single line.
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
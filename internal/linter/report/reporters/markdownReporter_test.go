@@ -0,0 +1,168 @@
+package reporters_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/internal/linter/config"
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+func TestMarkdownReporter_Report(t *testing.T) {
+	failures := []report.Failure{
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "proto/example.proto",
+				Offset:   100,
+				Line:     5,
+				Column:   10,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			string(rule.SeverityError),
+			`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+		),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "other.proto",
+			},
+			"FILE_HAS_COMMENT",
+			string(rule.SeverityNote),
+			"File should have a <comment>",
+		),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "proto/example.proto",
+				Offset:   200,
+				Line:     10,
+				Column:   20,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			string(rule.SeverityWarning),
+			`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+		).WithFixed(true),
+	}
+	const summary = `## protolint
+
+**3 problems** (1 error, 1 warning, 1 note) in 2 files, 1 fixed.
+
+<details>
+<summary>Problems by rule</summary>
+
+| Rule | Errors | Warnings | Notes | Total |
+| --- | ---: | ---: | ---: | ---: |
+| ` + "`ENUM_NAMES_UPPER_CAMEL_CASE`" + ` | 1 | 1 | 0 | 2 |
+| ` + "`FILE_HAS_COMMENT`" + ` | 0 | 0 | 1 | 1 |
+
+</details>
+`
+
+	tests := []struct {
+		name          string
+		inputOption   config.MarkdownReporterOption
+		inputFailures []report.Failure
+		wantOutput    string
+	}{
+		{
+			name:          "Prints the summary and the findings per file",
+			inputFailures: failures,
+			wantOutput: summary + `
+### ` + "`proto/example.proto`" + `
+
+- :x: proto/example.proto:5:10 **ENUM_NAMES_UPPER_CAMEL_CASE** EnumField name "fIRST\_VALUE" must be CAPITALS\_WITH\_UNDERSCORES
+- :warning: proto/example.proto:10:20 **ENUM_NAMES_UPPER_CAMEL_CASE** EnumField name "SECOND.VALUE" must be CAPITALS\_WITH\_UNDERSCORES _(fixed)_
+
+### ` + "`other.proto`" + `
+
+- :information_source: other.proto **FILE_HAS_COMMENT** File should have a \<comment\>
+`,
+		},
+		{
+			name: "Links the findings to the lines",
+			inputOption: config.MarkdownReporterOption{
+				BaseURL: "https://github.com/owner/repo/blob/main/",
+			},
+			inputFailures: failures,
+			wantOutput: summary + `
+### ` + "`proto/example.proto`" + `
+
+- :x: [proto/example.proto:5:10](https://github.com/owner/repo/blob/main/proto/example.proto#L5) **ENUM_NAMES_UPPER_CAMEL_CASE** EnumField name "fIRST\_VALUE" must be CAPITALS\_WITH\_UNDERSCORES
+- :warning: [proto/example.proto:10:20](https://github.com/owner/repo/blob/main/proto/example.proto#L10) **ENUM_NAMES_UPPER_CAMEL_CASE** EnumField name "SECOND.VALUE" must be CAPITALS\_WITH\_UNDERSCORES _(fixed)_
+
+### ` + "`other.proto`" + `
+
+- :information_source: [other.proto](https://github.com/owner/repo/blob/main/other.proto) **FILE_HAS_COMMENT** File should have a \<comment\>
+`,
+		},
+		{
+			name: "Truncates the findings",
+			inputOption: config.MarkdownReporterOption{
+				MaxFindings: 1,
+			},
+			inputFailures: failures,
+			wantOutput: summary + `
+### ` + "`proto/example.proto`" + `
+
+- :x: proto/example.proto:5:10 **ENUM_NAMES_UPPER_CAMEL_CASE** EnumField name "fIRST\_VALUE" must be CAPITALS\_WITH\_UNDERSCORES
+
+_...and 2 more._
+`,
+		},
+		{
+			name:       "Prints no problems",
+			wantOutput: "## protolint\n\nNo problems found.\n",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.MarkdownReporter{}.WithMarkdownOption(test.inputOption).Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
+
+func TestMarkdownReporter_ReportTruncatesBySize(t *testing.T) {
+	var failures []report.Failure
+	for i := 1; i <= 1000; i++ {
+		failures = append(failures, report.Failuref(
+			meta.Position{Filename: "example.proto", Line: i, Column: 1},
+			"MAX_LINE_LENGTH",
+			"The line length is 100, but it must be shorter than 80",
+		))
+	}
+	option := config.MarkdownReporterOption{
+		MaxFindings: len(failures),
+		MaxBytes:    2000,
+	}
+
+	buf := &bytes.Buffer{}
+	err := reporters.MarkdownReporter{}.WithMarkdownOption(option).Report(buf, failures)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	if option.MaxBytes < buf.Len() {
+		t.Errorf("got %d bytes, but want at most %d", buf.Len(), option.MaxBytes)
+	}
+	shown := strings.Count(buf.String(), "**MAX_LINE_LENGTH**")
+	if shown == 0 {
+		t.Fatalf("got no findings, but want some")
+	}
+	wantNote := fmt.Sprintf("_...and %d more._\n", len(failures)-shown)
+	if !strings.HasSuffix(buf.String(), wantNote) {
+		t.Errorf("got %s, but want it to end with %s", buf.String(), wantNote)
+	}
+}
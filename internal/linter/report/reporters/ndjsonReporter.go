@@ -0,0 +1,99 @@
+package reporters
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// NDJSONReporter prints failures as newline-delimited JSON, one object per line.
+// It streams the failures of each file as soon as the file is linted, and ends with a summary line.
+//
+// The format is:
+//
+//	{"type":"failure","file":FILENAME,"line":LINE,"column":COL,"rule":RULE,"severity":SEVERITY,"message":MESSAGE,"fixed":FIXED}
+//	{"type":"summary","total":TOTAL,"errors":ERRORS,"warnings":WARNINGS,"notes":NOTES,"fixed":FIXED_COUNT}
+type NDJSONReporter struct {
+	summary ndjsonSummary
+}
+
+// NewNDJSONReporter creates a new NDJSONReporter.
+func NewNDJSONReporter() *NDJSONReporter {
+	return &NDJSONReporter{}
+}
+
+type ndjsonFailure struct {
+	Type     string `json:"type"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	Fixed    bool   `json:"fixed"`
+}
+
+type ndjsonSummary struct {
+	Type     string `json:"type"`
+	Total    int    `json:"total"`
+	Errors   int    `json:"errors"`
+	Warnings int    `json:"warnings"`
+	Notes    int    `json:"notes"`
+	Fixed    int    `json:"fixed"`
+}
+
+// Report writes failures to w.
+func (r *NDJSONReporter) Report(w io.Writer, fs []report.Failure) error {
+	// Start over, because the reporter can report again in watch mode.
+	reporter := NewNDJSONReporter()
+	err := reporter.ReportFile(w, fs)
+	if err != nil {
+		return err
+	}
+	return reporter.Finish(w)
+}
+
+// ReportFile writes the failures of a linted file to w.
+func (r *NDJSONReporter) ReportFile(w io.Writer, fs []report.Failure) error {
+	enc := json.NewEncoder(w)
+	for _, f := range fs {
+		severity := normalizedSeverity(f.Severity())
+		err := enc.Encode(ndjsonFailure{
+			Type:     "failure",
+			File:     f.Pos().Filename,
+			Line:     f.Pos().Line,
+			Column:   f.Pos().Column,
+			Rule:     f.RuleID(),
+			Severity: severity,
+			Message:  f.Message(),
+			Fixed:    f.Fixed(),
+		})
+		if err != nil {
+			return err
+		}
+
+		r.summary.Total++
+		switch rule.Severity(severity) {
+		case rule.SeverityWarning:
+			r.summary.Warnings++
+		case rule.SeverityNote:
+			r.summary.Notes++
+		default:
+			r.summary.Errors++
+		}
+		if f.Fixed() {
+			r.summary.Fixed++
+		}
+	}
+	return nil
+}
+
+// Finish writes the summary line to w.
+func (r *NDJSONReporter) Finish(w io.Writer) error {
+	summary := r.summary
+	summary.Type = "summary"
+	r.summary = ndjsonSummary{}
+	return json.NewEncoder(w).Encode(summary)
+}
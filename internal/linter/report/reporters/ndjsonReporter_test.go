@@ -0,0 +1,108 @@
+package reporters_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+func TestNDJSONReporter_Report(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		wantOutput    string
+	}{
+		{
+			name: "Prints failures in NDJSON format followed by the summary",
+			inputFailures: []report.Failure{
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   200,
+						Line:     10,
+						Column:   20,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityWarning),
+					`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				).WithFixed(true),
+			},
+			wantOutput: `{"type":"failure","file":"example.proto","line":5,"column":10,"rule":"ENUM_NAMES_UPPER_CAMEL_CASE","severity":"error","message":"EnumField name \"fIRST_VALUE\" must be CAPITALS_WITH_UNDERSCORES","fixed":false}
+{"type":"failure","file":"example.proto","line":10,"column":20,"rule":"ENUM_NAMES_UPPER_CAMEL_CASE","severity":"warning","message":"EnumField name \"SECOND.VALUE\" must be CAPITALS_WITH_UNDERSCORES","fixed":true}
+{"type":"summary","total":2,"errors":1,"warnings":1,"notes":0,"fixed":1}
+`,
+		},
+		{
+			name: "Prints only the summary without failures",
+			wantOutput: `{"type":"summary","total":0,"errors":0,"warnings":0,"notes":0,"fixed":0}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.NewNDJSONReporter().Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
+
+func TestNDJSONReporter_ReportFile(t *testing.T) {
+	reporter := reporters.NewNDJSONReporter()
+	buf := &bytes.Buffer{}
+
+	err := reporter.ReportFile(buf, []report.Failure{
+		report.FailureWithSeverityf(
+			meta.Position{Filename: "a.proto", Line: 1, Column: 1},
+			"FILE_HAS_COMMENT",
+			string(rule.SeverityNote),
+			"File should have a comment",
+		),
+	})
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	want := `{"type":"failure","file":"a.proto","line":1,"column":1,"rule":"FILE_HAS_COMMENT","severity":"note","message":"File should have a comment","fixed":false}
+`
+	if buf.String() != want {
+		t.Errorf("got %s after the first file, but want %s", buf.String(), want)
+	}
+
+	err = reporter.ReportFile(buf, nil)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	err = reporter.Finish(buf)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	want += `{"type":"summary","total":1,"errors":0,"warnings":0,"notes":1,"fixed":0}
+`
+	if buf.String() != want {
+		t.Errorf("got %s after finishing, but want %s", buf.String(), want)
+	}
+}
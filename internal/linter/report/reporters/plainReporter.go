@@ -8,12 +8,26 @@ import (
 )
 
 // PlainReporter prints failures as it is.
+// A fixed failure is suffixed with "(fixed)", and the number of them follows the failures.
 type PlainReporter struct{}
 
 // Report writes failures to w.
 func (r PlainReporter) Report(w io.Writer, fs []report.Failure) error {
 	for _, failure := range fs {
-		_, err := fmt.Fprintln(w, failure)
+		var err error
+		if failure.Fixed() {
+			_, err = fmt.Fprintf(w, "%s (fixed)\n", failure)
+		} else {
+			_, err = fmt.Fprintln(w, failure)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	fixed := report.CountFixed(fs)
+	if 0 < fixed {
+		_, err := fmt.Fprintf(w, "%d fixed, %d remaining\n", fixed, len(fs)-fixed)
 		if err != nil {
 			return err
 		}
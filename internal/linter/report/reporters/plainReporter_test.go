@@ -42,6 +42,35 @@ func TestPlainReporter_Report(t *testing.T) {
 			},
 			wantOutput: `[example.proto:5:10] EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES
 [example.proto:10:20] EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES
+`,
+		},
+		{
+			name: "Prints fixed failures with the count",
+			inputFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				).WithFixed(true),
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   200,
+						Line:     10,
+						Column:   20,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+			},
+			wantOutput: `[example.proto:5:10] EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES (fixed)
+[example.proto:10:20] EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES
+1 fixed, 1 remaining
 `,
 		},
 	}
@@ -0,0 +1,236 @@
+package reporters
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// PrettyReporter prints failures grouped by file for reading in a terminal.
+// Each failure has the rule ID and a code frame with a caret under the column.
+// It prints in colors only when it writes to a terminal and NO_COLOR isn't set.
+type PrettyReporter struct {
+	// fixable holds whether the rules are fixable keyed by the rule ID. It can be nil.
+	fixable map[string]bool
+	// readSource reads the sources. It's nil to read them from disk.
+	readSource internalreport.SourceReader
+}
+
+// WithRuleInfos returns the reporter which counts the failures fixable with -fix.
+func (r PrettyReporter) WithRuleInfos(infos []internalrule.Info) internalreport.Reporter {
+	m := make(map[string]bool)
+	for _, info := range infos {
+		m[info.ID] = info.Fixable
+	}
+	r.fixable = m
+	return r
+}
+
+// WithSources returns the reporter which prints the code frames read with read.
+func (r PrettyReporter) WithSources(read internalreport.SourceReader) internalreport.Reporter {
+	r.readSource = read
+	return r
+}
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[1;31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[1;33m"
+	ansiBlue   = "\x1b[1;34m"
+	ansiCyan   = "\x1b[1;36m"
+)
+
+// prettyPrinter prints the text in the color if the colors are enabled.
+type prettyPrinter struct {
+	w     io.Writer
+	color bool
+	err   error
+}
+
+func (p *prettyPrinter) printf(color string, format string, a ...interface{}) {
+	if p.err != nil {
+		return
+	}
+	s := fmt.Sprintf(format, a...)
+	if p.color && color != "" && s != "" {
+		s = color + s + ansiReset
+	}
+	_, p.err = io.WriteString(p.w, s)
+}
+
+// Report writes failures to w.
+func (r PrettyReporter) Report(w io.Writer, fs []report.Failure) error {
+	if len(fs) == 0 {
+		return nil
+	}
+	p := &prettyPrinter{w: w, color: isColorTerminal(w)}
+
+	var files []string
+	byFile := make(map[string][]report.Failure)
+	for _, f := range fs {
+		name := f.Pos().Filename
+		if _, ok := byFile[name]; !ok {
+			files = append(files, name)
+		}
+		byFile[name] = append(byFile[name], f)
+	}
+
+	for _, name := range files {
+		p.printf(ansiBold, "%s", name)
+		p.printf("", "\n")
+		lines := readSourceLines(r.readSource, name)
+		for _, f := range byFile[name] {
+			p.printf("", "\n")
+			printPrettyFailure(p, f, lines)
+		}
+		p.printf("", "\n")
+	}
+	p.printf(ansiBold, "%s", r.summary(fs, len(files)))
+	p.printf("", "\n")
+	return p.err
+}
+
+// printPrettyFailure prints the failure like the following:
+//
+//	error[MAX_LINE_LENGTH]: The line length is 113, but it must be shorter than 80
+//	 --> example.proto:3:1
+//	  |
+//	3 | string name = 1; // ...
+//	  | ^
+func printPrettyFailure(
+	p *prettyPrinter,
+	f report.Failure,
+	lines []string,
+) {
+	severity := normalizedSeverity(f.Severity())
+	color := prettySeverityColor(severity)
+	p.printf(color, "%s[%s]", severity, f.RuleID())
+	p.printf(ansiBold, ": %s", f.Message())
+	if f.Fixed() {
+		p.printf(ansiGreen, " (fixed)")
+	}
+	p.printf("", "\n")
+
+	pos := f.Pos()
+	if pos.Line < 1 || len(lines) < pos.Line {
+		// A failure about the whole file has no line. A file which can't be read has no code frame.
+		p.printf(ansiBlue, " --> ")
+		if pos.Line < 1 {
+			p.printf("", "%s\n", pos.Filename)
+		} else {
+			p.printf("", "%s:%d:%d\n", pos.Filename, pos.Line, pos.Column)
+		}
+		return
+	}
+
+	number := strconv.Itoa(pos.Line)
+	gutter := strings.Repeat(" ", len(number))
+	p.printf(ansiBlue, "%s--> ", gutter)
+	p.printf("", "%s:%d:%d\n", pos.Filename, pos.Line, pos.Column)
+	p.printf(ansiBlue, "%s |", gutter)
+	p.printf("", "\n")
+	p.printf(ansiBlue, "%s |", number)
+	p.printf("", " %s\n", lines[pos.Line-1])
+	p.printf(ansiBlue, "%s |", gutter)
+	p.printf("", " %s", caretIndent(lines[pos.Line-1], pos.Column))
+	p.printf(color, "^")
+	p.printf("", "\n")
+}
+
+// caretIndent returns the indent to put a caret under the column, which counts the characters from 1.
+// It keeps the tabs so that the caret is aligned with the line.
+func caretIndent(line string, column int) string {
+	text := []rune(line)
+	c := min(max(column, 1), len(text)+1) - 1
+	var indent strings.Builder
+	for _, r := range text[:c] {
+		if r == '\t' {
+			indent.WriteRune('\t')
+		} else {
+			indent.WriteRune(' ')
+		}
+	}
+	return indent.String()
+}
+
+func prettySeverityColor(severity string) string {
+	switch rule.Severity(severity) {
+	case rule.SeverityWarning:
+		return ansiYellow
+	case rule.SeverityNote:
+		return ansiCyan
+	}
+	return ansiRed
+}
+
+// summary returns the summary like "12 errors, 3 warnings in 5 files (4 fixable with -fix)".
+func (r PrettyReporter) summary(fs []report.Failure, files int) string {
+	counts := make(map[string]int)
+	fixable := 0
+	for _, f := range fs {
+		counts[normalizedSeverity(f.Severity())]++
+		if !f.Fixed() && r.fixable[f.RuleID()] {
+			fixable++
+		}
+	}
+
+	var parts []string
+	for _, s := range []struct {
+		severity rule.Severity
+		noun     string
+	}{
+		{rule.SeverityError, "error"},
+		{rule.SeverityWarning, "warning"},
+		{rule.SeverityNote, "note"},
+	} {
+		if n := counts[string(s.severity)]; 0 < n {
+			parts = append(parts, plural(n, s.noun))
+		}
+	}
+	summary := fmt.Sprintf("%s in %s", strings.Join(parts, ", "), plural(files, "file"))
+
+	var notes []string
+	if fixed := report.CountFixed(fs); 0 < fixed {
+		notes = append(notes, fmt.Sprintf("%d fixed", fixed))
+	}
+	if 0 < fixable {
+		notes = append(notes, fmt.Sprintf("%d fixable with -fix", fixable))
+	}
+	if 0 < len(notes) {
+		summary += " (" + strings.Join(notes, ", ") + ")"
+	}
+	return summary
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// isColorTerminal reports whether w is a terminal and the colors aren't disabled by NO_COLOR.
+// Refer to https://no-color.org.
+func isColorTerminal(w io.Writer) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
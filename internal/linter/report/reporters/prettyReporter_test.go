@@ -0,0 +1,146 @@
+package reporters_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+func TestPrettyReporter_Report(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "example.proto")
+	err := os.WriteFile(path, []byte("syntax = \"proto3\";\n\nenum enumName {\n\tfIRST_VALUE = 0;\n}\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPath := filepath.Join(dir, "missing.proto")
+
+	infos := []internalrule.Info{
+		{ID: "ENUM_NAMES_UPPER_CAMEL_CASE", Fixable: true},
+		{ID: "ENUM_FIELD_NAMES_UPPER_SNAKE_CASE", Fixable: true},
+	}
+
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		wantOutput    string
+	}{
+		{
+			name: "Prints failures grouped by file with the code frames and the summary",
+			inputFailures: []report.Failure{
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: path,
+						Line:     3,
+						Column:   6,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`Enum name "enumName" must be UpperCamelCase like "EnumName"`,
+				),
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: otherPath,
+					},
+					"FILE_HAS_COMMENT",
+					string(rule.SeverityNote),
+					"File should have a comment",
+				),
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: path,
+						Line:     4,
+						Column:   2,
+					},
+					"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+					string(rule.SeverityWarning),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				).WithFixed(true),
+			},
+			wantOutput: path + `
+
+error[ENUM_NAMES_UPPER_CAMEL_CASE]: Enum name "enumName" must be UpperCamelCase like "EnumName"
+ --> ` + path + `:3:6
+  |
+3 | enum enumName {
+  |      ^
+
+warning[ENUM_FIELD_NAMES_UPPER_SNAKE_CASE]: EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES (fixed)
+ --> ` + path + `:4:2
+  |
+4 | 	fIRST_VALUE = 0;
+  | 	^
+
+` + otherPath + `
+
+note[FILE_HAS_COMMENT]: File should have a comment
+ --> ` + otherPath + `
+
+1 error, 1 warning, 1 note in 2 files (1 fixed, 1 fixable with -fix)
+`,
+		},
+		{
+			name:       "Prints nothing without failures",
+			wantOutput: "",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.PrettyReporter{}.WithRuleInfos(infos).Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
+
+func TestPrettyReporter_ReportWithSources(t *testing.T) {
+	read := func(path string) ([]byte, error) {
+		if path != "stdin.proto" {
+			return nil, os.ErrNotExist
+		}
+		return []byte("syntax = \"proto3\";\nenum enumName {}\n"), nil
+	}
+	failures := []report.Failure{
+		report.Failuref(
+			meta.Position{
+				Filename: "stdin.proto",
+				Line:     2,
+				Column:   6,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			`Enum name "enumName" must be UpperCamelCase like "EnumName"`,
+		),
+	}
+
+	buf := &bytes.Buffer{}
+	err := reporters.PrettyReporter{}.WithSources(read).Report(buf, failures)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	want := `error[ENUM_NAMES_UPPER_CAMEL_CASE]: Enum name "enumName" must be UpperCamelCase like "EnumName"
+ --> stdin.proto:2:6
+  |
+2 | enum enumName {}
+  |      ^
+`
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("got %s, but want it to contain %s", buf.String(), want)
+	}
+}
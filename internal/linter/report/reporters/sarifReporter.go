@@ -2,8 +2,14 @@ package reporters
 
 import (
 	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/chavacava/garif"
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
 	"github.com/yoheimuta/protolint/linter/report"
 	"github.com/yoheimuta/protolint/linter/rule"
 )
@@ -14,7 +20,68 @@ import (
 // Standard.
 // Refer to http://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
 // for details to the format.
-type SarifReporter struct{}
+type SarifReporter struct {
+	// infos describe the rules keyed by the rule ID. It can be nil.
+	infos map[string]internalrule.Info
+}
+
+// WithRuleInfos returns the reporter which fills the rule descriptors with the infos.
+func (r SarifReporter) WithRuleInfos(infos []internalrule.Info) internalreport.Reporter {
+	m := make(map[string]internalrule.Info)
+	for _, info := range infos {
+		m[info.ID] = info
+	}
+	return SarifReporter{infos: m}
+}
+
+// newRule creates a descriptor of the rule, which is described in detail if the info is known.
+func (r SarifReporter) newRule(id string) *garif.ReportingDescriptor {
+	descriptor := garif.NewRule(id)
+	info, ok := r.infos[id]
+	if !ok {
+		return descriptor.WithHelpUri("https://github.com/yoheimuta/protolint")
+	}
+
+	descriptor.ShortDescription = garif.NewMultiformatMessageString(info.Purpose)
+	if info.Description != "" {
+		descriptor.FullDescription = garif.NewMultiformatMessageString(info.Description)
+	}
+	descriptor.Help = &garif.MultiformatMessageString{
+		Text:     info.Text(),
+		Markdown: info.Markdown(),
+	}
+	descriptor.DefaultConfiguration = &garif.ReportingConfiguration{
+		Level: getResultLevel(info.Severity),
+	}
+	if 0 < len(info.Categories) {
+		descriptor.WithProperties("tags", info.Categories)
+	}
+	helpURI := info.DocumentationURL
+	if helpURI == "" {
+		helpURI = "https://github.com/yoheimuta/protolint"
+	}
+	return descriptor.WithHelpUri(helpURI)
+}
+
+const (
+	// sarifFingerprintKey is versioned, so that a change of the fingerprint doesn't match the old ones.
+	sarifFingerprintKey = "protolintFingerprint/v1"
+	// sarifSrcRoot is the conventional base ID of the source root.
+	sarifSrcRoot = "%SRCROOT%"
+)
+
+// fileURI returns the file URI of the directory, ending with a slash as a base URI must.
+func fileURI(dir string) string {
+	path := filepath.ToSlash(dir)
+	if !strings.HasPrefix(path, "/") {
+		// A Windows path like C:/src.
+		path = "/" + path
+	}
+	if !strings.HasSuffix(path, "/") {
+		path += "/"
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
 
 var allSeverities map[string]rule.Severity = map[string]rule.Severity{
 	string(rule.SeverityError):   rule.SeverityError,
@@ -42,13 +109,12 @@ func (r SarifReporter) Report(w io.Writer, fs []report.Failure) error {
 
 	run := garif.NewRun(garif.NewTool(tool))
 
-	for _, failure := range fs {
+	unfixed := report.Unfixed(fs)
+	fps := fingerprints(unfixed)
+	for i, failure := range unfixed {
 		_, ruleFound := rulesByID[failure.RuleID()]
 		if !ruleFound {
-			rule := garif.NewRule(
-				failure.RuleID(),
-			).
-				WithHelpUri("https://github.com/yoheimuta/protolint")
+			rule := r.newRule(failure.RuleID())
 
 			rulesByID[failure.RuleID()] = rule
 			allRules = append(allRules, rule)
@@ -70,6 +136,17 @@ func (r SarifReporter) Report(w io.Writer, fs []report.Failure) error {
 
 			recentResult := run.Results[len(run.Results)-1]
 			recentResult.Kind = garif.ResultKind_Fail
+			recentResult.PartialFingerprints = map[string]string{
+				sarifFingerprintKey: fps[i],
+			}
+			if !filepath.IsAbs(failure.Pos().Filename) {
+				recentResult.Locations[0].PhysicalLocation.ArtifactLocation.UriBaseId = sarifSrcRoot
+			}
+			if end := failure.End(); 0 < end.Line {
+				region := recentResult.Locations[0].PhysicalLocation.Region
+				region.EndLine = end.Line
+				region.EndColumn = end.Column
+			}
 
 			if lvl, ok := allSeverities[failure.Severity()]; ok {
 				recentResult.Level = getResultLevel(lvl)
@@ -77,8 +154,30 @@ func (r SarifReporter) Report(w io.Writer, fs []report.Failure) error {
 		}
 	}
 
+	if fixed := report.CountFixed(fs); 0 < fixed {
+		run.Properties = &garif.PropertyBag{"fixedCount": fixed}
+	}
+
+	// The exit status follows the one of the lint command, which fails if any failure remains unfixed.
+	invocation := garif.NewInvocation(true)
+	if len(fs) != report.CountFixed(fs) {
+		invocation.ExitCode = 1
+	}
+	run.Invocations = []*garif.Invocation{invocation}
+
 	tool.WithRules(allRules...)
 	run.WithArtifactsURIs(artifactLocations...)
+	for _, artifact := range run.Artifacts {
+		if !filepath.IsAbs(artifact.Location.Uri) {
+			artifact.Location.UriBaseId = sarifSrcRoot
+		}
+	}
+	// The relative paths are resolved against the working directory, which is usually the repository root.
+	if wd, err := os.Getwd(); err == nil {
+		run.OriginalUriBaseIds = map[string]*garif.ArtifactLocation{
+			sarifSrcRoot: {Uri: fileURI(wd)},
+		}
+	}
 
 	logFile := garif.NewLogFile([]*garif.Run{run}, garif.Version210)
 	return logFile.PrettyWrite(w)
@@ -2,11 +2,16 @@ package reporters_test
 
 import (
 	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
 
 	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
 	"github.com/yoheimuta/protolint/linter/report"
 	"github.com/yoheimuta/protolint/linter/rule"
 )
@@ -30,6 +35,13 @@ func TestSarifReporter_Report(t *testing.T) {
 					"ENUM_NAMES_UPPER_CAMEL_CASE",
 					string(rule.SeverityError),
 					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				).WithEnd(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   111,
+						Line:     5,
+						Column:   21,
+					},
 				),
 				report.FailureWithSeverityf(
 					meta.Position{
@@ -49,10 +61,22 @@ func TestSarifReporter_Report(t *testing.T) {
       "artifacts": [
         {
           "location": {
-            "uri": "example.proto"
+            "uri": "example.proto",
+            "uriBaseId": "%SRCROOT%"
           }
         }
       ],
+      "invocations": [
+        {
+          "executionSuccessful": true,
+          "exitCode": 1
+        }
+      ],
+      "originalUriBaseIds": {
+        "%SRCROOT%": {
+          "uri": "SRCROOT_URI"
+        }
+      },
       "results": [
         {
           "kind": "fail",
@@ -61,9 +85,12 @@ func TestSarifReporter_Report(t *testing.T) {
             {
               "physicalLocation": {
                 "artifactLocation": {
-                  "uri": "example.proto"
+                  "uri": "example.proto",
+                  "uriBaseId": "%SRCROOT%"
                 },
                 "region": {
+                  "endColumn": 21,
+                  "endLine": 5,
                   "startColumn": 10,
                   "startLine": 5
                 }
@@ -73,6 +100,9 @@ func TestSarifReporter_Report(t *testing.T) {
           "message": {
             "text": "EnumField name \"fIRST_VALUE\" must be CAPITALS_WITH_UNDERSCORES"
           },
+          "partialFingerprints": {
+            "protolintFingerprint/v1": "de6af2e2f9e7e9cb2ed7e64d630e923236432a2eb5a60d658d83298d956c98e8"
+          },
           "ruleId": "ENUM_NAMES_UPPER_CAMEL_CASE"
         },
         {
@@ -82,7 +112,8 @@ func TestSarifReporter_Report(t *testing.T) {
             {
               "physicalLocation": {
                 "artifactLocation": {
-                  "uri": "example.proto"
+                  "uri": "example.proto",
+                  "uriBaseId": "%SRCROOT%"
                 },
                 "region": {
                   "startColumn": 20,
@@ -94,6 +125,9 @@ func TestSarifReporter_Report(t *testing.T) {
           "message": {
             "text": "EnumField name \"SECOND.VALUE\" must be CAPITALS_WITH_UNDERSCORES"
           },
+          "partialFingerprints": {
+            "protolintFingerprint/v1": "377dc935bb9d5d0b38aa47eaa16f665e215d67659dde14cd20317ece28d66717"
+          },
           "ruleId": "ENUM_NAMES_UPPER_CAMEL_CASE"
         }
       ],
@@ -125,9 +159,265 @@ func TestSarifReporter_Report(t *testing.T) {
 				t.Errorf("got err %v, but want nil", err)
 				return
 			}
-			if buf.String() != test.wantOutput {
-				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			wantOutput := strings.ReplaceAll(test.wantOutput, "SRCROOT_URI", srcRootURI(t))
+			if buf.String() != wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), wantOutput)
 			}
 		})
 	}
 }
+
+func srcRootURI(t *testing.T) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "file://" + filepath.ToSlash(wd) + "/"
+}
+
+func TestSarifReporter_WithRuleInfos(t *testing.T) {
+	failures := []report.Failure{
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+				Line:     5,
+				Column:   10,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			string(rule.SeverityWarning),
+			`Enum name "foo" must be UpperCamelCase`,
+		),
+		report.Failuref(
+			meta.Position{
+				Filename: "example.proto",
+				Line:     6,
+				Column:   1,
+			},
+			"UNKNOWN_RULE",
+			"unknown",
+		),
+	}
+	infos := []internalrule.Info{
+		{
+			ID:               "ENUM_NAMES_UPPER_CAMEL_CASE",
+			Purpose:          "Verifies that all enum names are CamelCase (with an initial capital).",
+			Description:      "Enum names must be written in UpperCamelCase.",
+			Severity:         rule.SeverityWarning,
+			DocumentationURL: "https://example.com/enum",
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	err := reporters.SarifReporter{}.WithRuleInfos(infos).Report(buf, failures)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+
+	var got struct {
+		Runs []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						ID               string `json:"id"`
+						HelpURI          string `json:"helpUri"`
+						ShortDescription *struct {
+							Text string `json:"text"`
+						} `json:"shortDescription"`
+						FullDescription *struct {
+							Text string `json:"text"`
+						} `json:"fullDescription"`
+						Help *struct {
+							Text     string `json:"text"`
+							Markdown string `json:"markdown"`
+						} `json:"help"`
+						DefaultConfiguration *struct {
+							Level string `json:"level"`
+						} `json:"defaultConfiguration"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+		} `json:"runs"`
+	}
+	err = json.Unmarshal(buf.Bytes(), &got)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+
+	rules := got.Runs[0].Tool.Driver.Rules
+	if len(rules) != 2 {
+		t.Fatalf("got %d rules, but want 2", len(rules))
+	}
+
+	described := rules[0]
+	if described.HelpURI != "https://example.com/enum" {
+		t.Errorf("got helpUri %s, but want https://example.com/enum", described.HelpURI)
+	}
+	if described.ShortDescription == nil || described.ShortDescription.Text != infos[0].Purpose {
+		t.Errorf("got shortDescription %v, but want %s", described.ShortDescription, infos[0].Purpose)
+	}
+	if described.FullDescription == nil || described.FullDescription.Text != infos[0].Description {
+		t.Errorf("got fullDescription %v, but want %s", described.FullDescription, infos[0].Description)
+	}
+	if described.Help == nil || described.Help.Text == "" || described.Help.Markdown == "" {
+		t.Errorf("got help %v, but want text and markdown", described.Help)
+	}
+	if described.DefaultConfiguration == nil || described.DefaultConfiguration.Level != "warning" {
+		t.Errorf("got defaultConfiguration %v, but want warning", described.DefaultConfiguration)
+	}
+
+	unknown := rules[1]
+	if unknown.HelpURI != "https://github.com/yoheimuta/protolint" || unknown.ShortDescription != nil || unknown.Help != nil {
+		t.Errorf("got %v, but want only the fallback helpUri", unknown)
+	}
+}
+
+func TestSarifReporter_ReportFixed(t *testing.T) {
+	failures := []report.Failure{
+		report.Failuref(
+			meta.Position{
+				Filename: "example.proto",
+				Line:     5,
+				Column:   10,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			`Enum name "foo" must be UpperCamelCase`,
+		).WithFixed(true),
+		report.Failuref(
+			meta.Position{
+				Filename: "example.proto",
+				Line:     6,
+				Column:   1,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			`Enum name "bar" must be UpperCamelCase`,
+		),
+	}
+
+	buf := &bytes.Buffer{}
+	err := reporters.SarifReporter{}.Report(buf, failures)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+
+	var got struct {
+		Runs []struct {
+			Properties map[string]interface{} `json:"properties"`
+			Results    []struct {
+				Message struct {
+					Text string `json:"text"`
+				} `json:"message"`
+				Properties map[string]interface{} `json:"properties"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	err = json.Unmarshal(buf.Bytes(), &got)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+
+	run := got.Runs[0]
+	if run.Properties["fixedCount"] != float64(1) {
+		t.Errorf("got the fixedCount %v, but want 1", run.Properties["fixedCount"])
+	}
+	// The fixed failure is left out, because code scanning would show it as an open alert.
+	if len(run.Results) != 1 || run.Results[0].Message.Text != `Enum name "bar" must be UpperCamelCase` {
+		t.Fatalf("got the results %v, but want only the unfixed one", run.Results)
+	}
+	if run.Results[0].Properties != nil {
+		t.Errorf("got the unfixed result properties %v, but want nil", run.Results[0].Properties)
+	}
+}
+
+func TestSarifReporter_ReportFingerprintsAndInvocation(t *testing.T) {
+	absPath, err := filepath.Abs("example.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newFailures := func(line int) []report.Failure {
+		return []report.Failure{
+			report.Failuref(
+				meta.Position{Filename: "example.proto", Line: line, Column: 1},
+				"MAX_LINE_LENGTH",
+				"The line length is 100, but it must be shorter than 80",
+			),
+			report.Failuref(
+				meta.Position{Filename: "example.proto", Line: line + 1, Column: 1},
+				"MAX_LINE_LENGTH",
+				"The line length is 100, but it must be shorter than 80",
+			),
+			report.Failuref(
+				meta.Position{Filename: absPath, Line: line, Column: 1},
+				"MAX_LINE_LENGTH",
+				"The line length is 100, but it must be shorter than 80",
+			),
+			report.Failuref(
+				meta.Position{Filename: "example.proto", Line: line + 2, Column: 1},
+				"MAX_LINE_LENGTH",
+				"The line length is 100, but it must be shorter than 80",
+			).WithFixed(true),
+		}
+	}
+
+	type sarifRun struct {
+		Invocations []struct {
+			ExecutionSuccessful bool `json:"executionSuccessful"`
+			ExitCode            int  `json:"exitCode"`
+		} `json:"invocations"`
+		Results []struct {
+			PartialFingerprints map[string]string `json:"partialFingerprints"`
+			Locations           []struct {
+				PhysicalLocation struct {
+					ArtifactLocation struct {
+						URIBaseID string `json:"uriBaseId"`
+					} `json:"artifactLocation"`
+				} `json:"physicalLocation"`
+			} `json:"locations"`
+		} `json:"results"`
+	}
+	reportRun := func(failures []report.Failure) sarifRun {
+		buf := &bytes.Buffer{}
+		err := reporters.SarifReporter{}.Report(buf, failures)
+		if err != nil {
+			t.Fatalf("got err %v, but want nil", err)
+		}
+		var got struct {
+			Runs []sarifRun `json:"runs"`
+		}
+		err = json.Unmarshal(buf.Bytes(), &got)
+		if err != nil {
+			t.Fatalf("got err %v, but want nil", err)
+		}
+		return got.Runs[0]
+	}
+
+	before := reportRun(newFailures(5))
+	after := reportRun(newFailures(10))
+	for i := range before.Results {
+		fp := before.Results[i].PartialFingerprints["protolintFingerprint/v1"]
+		if fp == "" || fp != after.Results[i].PartialFingerprints["protolintFingerprint/v1"] {
+			t.Errorf("got the fingerprints %v and %v, but want the same ones after the lines move",
+				before.Results[i].PartialFingerprints, after.Results[i].PartialFingerprints)
+		}
+	}
+	if before.Results[0].PartialFingerprints["protolintFingerprint/v1"] == before.Results[1].PartialFingerprints["protolintFingerprint/v1"] {
+		t.Errorf("got the same fingerprints for the different failures")
+	}
+
+	if got := before.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URIBaseID; got != "%SRCROOT%" {
+		t.Errorf("got the uriBaseId %q of the relative path, but want %%SRCROOT%%", got)
+	}
+	if got := before.Results[2].Locations[0].PhysicalLocation.ArtifactLocation.URIBaseID; got != "" {
+		t.Errorf("got the uriBaseId %q of the absolute path, but want none", got)
+	}
+
+	if len(before.Invocations) != 1 || !before.Invocations[0].ExecutionSuccessful || before.Invocations[0].ExitCode != 1 {
+		t.Errorf("got the invocations %v, but want the exit code 1", before.Invocations)
+	}
+	if len(before.Results) != 3 {
+		t.Errorf("got %d results, but want 3 without the fixed one", len(before.Results))
+	}
+	fixed := reportRun(newFailures(5)[3:])
+	if len(fixed.Invocations) != 1 || fixed.Invocations[0].ExitCode != 0 {
+		t.Errorf("got the invocations %v, but want the exit code 0 when all failures are fixed", fixed.Invocations)
+	}
+}
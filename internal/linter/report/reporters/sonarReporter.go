@@ -41,7 +41,7 @@ type sonarIssue struct {
 
 func (s SonarReporter) Report(w io.Writer, fs []report.Failure) error {
 	var issues []sonarIssue
-	for _, f := range fs {
+	for _, f := range report.Unfixed(fs) {
 		issue := sonarIssue{
 			EngineId:  protolintSonarEngineId,
 			RuleId:    f.RuleID(),
@@ -39,6 +39,16 @@ func TestSonarReporter_Report(t *testing.T) {
 					"ENUM_NAMES_UPPER_CAMEL_CASE",
 					`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
 				),
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   300,
+						Line:     15,
+						Column:   20,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					`EnumField name "third.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				).WithFixed(true),
 			},
 			wantOutput: `[
   {
@@ -0,0 +1,189 @@
+package reporters
+
+import (
+	"encoding/json"
+	"io"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// SonarV2Reporter prints failures in the generic issue format of SonarQube 10.3 and later,
+// which defines the rules with their clean code attributes and impacts, and the issues referring to them.
+// Unlike SonarReporter, the columns are 0-based as the format specifies,
+// and the issues have the end positions and the secondary locations if the rules know them.
+// A fixed failure is left out, because SonarQube would show it as an open issue.
+//
+// For details refer to https://docs.sonarsource.com/sonarqube/latest/analyzing-source-code/importing-external-issues/generic-issue-import-format/
+type SonarV2Reporter struct {
+	// infos holds the infos of the rules keyed by the rule ID. It can be nil.
+	infos map[string]internalrule.Info
+}
+
+// WithRuleInfos returns the reporter which defines the rules with the infos.
+func (r SonarV2Reporter) WithRuleInfos(infos []internalrule.Info) internalreport.Reporter {
+	m := make(map[string]internalrule.Info)
+	for _, info := range infos {
+		m[info.ID] = info
+	}
+	return SonarV2Reporter{infos: m}
+}
+
+type sonarV2Report struct {
+	Rules  []sonarV2Rule  `json:"rules"`
+	Issues []sonarV2Issue `json:"issues"`
+}
+
+type sonarV2Rule struct {
+	ID                 string          `json:"id"`
+	Name               string          `json:"name"`
+	Description        string          `json:"description"`
+	EngineID           string          `json:"engineId"`
+	CleanCodeAttribute string          `json:"cleanCodeAttribute"`
+	Impacts            []sonarV2Impact `json:"impacts"`
+}
+
+type sonarV2Impact struct {
+	SoftwareQuality string `json:"softwareQuality"`
+	Severity        string `json:"severity"`
+}
+
+type sonarV2Issue struct {
+	RuleID             string            `json:"ruleId"`
+	PrimaryLocation    sonarV2Location   `json:"primaryLocation"`
+	SecondaryLocations []sonarV2Location `json:"secondaryLocations,omitempty"`
+}
+
+type sonarV2Location struct {
+	Message  string `json:"message"`
+	FilePath string `json:"filePath"`
+	// TextRange is nil for a failure about the whole file.
+	TextRange *sonarV2TextRange `json:"textRange,omitempty"`
+}
+
+type sonarV2TextRange struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// Report writes failures to w.
+func (r SonarV2Reporter) Report(w io.Writer, fs []report.Failure) error {
+	out := sonarV2Report{
+		Rules:  []sonarV2Rule{},
+		Issues: []sonarV2Issue{},
+	}
+	defined := make(map[string]bool)
+	for _, f := range fs {
+		if f.Fixed() {
+			continue
+		}
+		if !defined[f.RuleID()] {
+			defined[f.RuleID()] = true
+			out.Rules = append(out.Rules, r.newRule(f))
+		}
+
+		issue := sonarV2Issue{
+			RuleID:          f.RuleID(),
+			PrimaryLocation: newSonarV2Location(f.Message(), f.Pos().Filename, f.Pos().Line, f.Pos().Column),
+		}
+		if end := f.End(); 0 < end.Line && issue.PrimaryLocation.TextRange != nil {
+			issue.PrimaryLocation.TextRange.EndLine = end.Line
+			issue.PrimaryLocation.TextRange.EndColumn = max(end.Column-1, 0)
+		}
+		for _, l := range f.SecondaryLocations() {
+			issue.SecondaryLocations = append(
+				issue.SecondaryLocations,
+				newSonarV2Location(l.Message, l.Pos.Filename, l.Pos.Line, l.Pos.Column),
+			)
+		}
+		out.Issues = append(out.Issues, issue)
+	}
+
+	bs, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bs)
+	return err
+}
+
+// newRule defines the rule of the failure, which is described in detail if the info is known.
+func (r SonarV2Reporter) newRule(f report.Failure) sonarV2Rule {
+	info, ok := r.infos[f.RuleID()]
+	severity := normalizedSeverity(f.Severity())
+	if ok && info.Severity != "" {
+		severity = normalizedSeverity(string(info.Severity))
+	}
+
+	name := f.RuleID()
+	if ok && info.Purpose != "" {
+		name = info.Purpose
+	}
+	description := name
+	if ok && info.Description != "" {
+		description = info.Description
+	}
+
+	var category string
+	if ok && 0 < len(info.Categories) {
+		category = info.Categories[0]
+	}
+	attribute, quality := sonarCleanCode(category)
+	return sonarV2Rule{
+		ID:                 f.RuleID(),
+		Name:               name,
+		Description:        description,
+		EngineID:           protolintSonarEngineId,
+		CleanCodeAttribute: attribute,
+		Impacts: []sonarV2Impact{
+			{
+				SoftwareQuality: quality,
+				Severity:        sonarImpactSeverity(severity),
+			},
+		},
+	}
+}
+
+// newSonarV2Location converts the 1-based column to the 0-based one.
+func newSonarV2Location(message, path string, line, column int) sonarV2Location {
+	location := sonarV2Location{
+		Message:  message,
+		FilePath: path,
+	}
+	if 0 < line {
+		location.TextRange = &sonarV2TextRange{
+			StartLine:   line,
+			StartColumn: max(column-1, 0),
+		}
+	}
+	return location
+}
+
+// sonarCleanCode returns the clean code attribute and the software quality of the category.
+func sonarCleanCode(category string) (string, string) {
+	switch category {
+	case rule.CategoryNaming:
+		return "IDENTIFIABLE", "MAINTAINABILITY"
+	case rule.CategoryStyle, rule.CategoryLayout:
+		return "FORMATTED", "MAINTAINABILITY"
+	case rule.CategoryDocumentation:
+		return "COMPLETE", "MAINTAINABILITY"
+	case rule.CategoryCompatibility:
+		return "LOGICAL", "RELIABILITY"
+	}
+	return "CONVENTIONAL", "MAINTAINABILITY"
+}
+
+func sonarImpactSeverity(severity string) string {
+	switch rule.Severity(severity) {
+	case rule.SeverityWarning:
+		return "MEDIUM"
+	case rule.SeverityNote:
+		return "LOW"
+	}
+	return "HIGH"
+}
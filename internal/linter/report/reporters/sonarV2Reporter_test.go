@@ -0,0 +1,169 @@
+package reporters_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+func TestSonarV2Reporter_Report(t *testing.T) {
+	infos := []internalrule.Info{
+		{
+			ID:          "IMPORTS_SORTED",
+			Purpose:     "Enforces sorted imports.",
+			Description: "The imports in a contiguous group must be sorted by the path.",
+			Severity:    rule.SeverityWarning,
+			Categories:  []string{rule.CategoryStyle},
+		},
+	}
+	failures := []report.Failure{
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+				Offset:   20,
+				Line:     3,
+				Column:   1,
+			},
+			"IMPORTS_SORTED",
+			string(rule.SeverityWarning),
+			`Imports are not sorted.`,
+		).WithEnd(meta.Position{
+			Filename: "example.proto",
+			Offset:   46,
+			Line:     3,
+			Column:   27,
+		}).WithSecondaryLocations(report.Location{
+			Pos: meta.Position{
+				Filename: "example.proto",
+				Offset:   47,
+				Line:     4,
+				Column:   1,
+			},
+			Message: "This import belongs at line 3.",
+		}),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+			},
+			"FILE_HAS_COMMENT",
+			string(rule.SeverityNote),
+			"File should have a comment",
+		),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+				Offset:   60,
+				Line:     6,
+				Column:   1,
+			},
+			"INDENT",
+			string(rule.SeverityError),
+			`Found an incorrect indentation style "  ". "" is correct.`,
+		).WithFixed(true),
+	}
+
+	tests := []struct {
+		name          string
+		inputReporter internalreport.Reporter
+		inputFailures []report.Failure
+		wantOutput    string
+	}{
+		{
+			name:          "Prints the rule definitions and the issues with the ranges and the secondary locations, leaving out the fixed ones",
+			inputReporter: reporters.SonarV2Reporter{}.WithRuleInfos(infos),
+			inputFailures: failures,
+			wantOutput: `{
+  "rules": [
+    {
+      "id": "IMPORTS_SORTED",
+      "name": "Enforces sorted imports.",
+      "description": "The imports in a contiguous group must be sorted by the path.",
+      "engineId": "protolint",
+      "cleanCodeAttribute": "FORMATTED",
+      "impacts": [
+        {
+          "softwareQuality": "MAINTAINABILITY",
+          "severity": "MEDIUM"
+        }
+      ]
+    },
+    {
+      "id": "FILE_HAS_COMMENT",
+      "name": "FILE_HAS_COMMENT",
+      "description": "FILE_HAS_COMMENT",
+      "engineId": "protolint",
+      "cleanCodeAttribute": "CONVENTIONAL",
+      "impacts": [
+        {
+          "softwareQuality": "MAINTAINABILITY",
+          "severity": "LOW"
+        }
+      ]
+    }
+  ],
+  "issues": [
+    {
+      "ruleId": "IMPORTS_SORTED",
+      "primaryLocation": {
+        "message": "Imports are not sorted.",
+        "filePath": "example.proto",
+        "textRange": {
+          "startLine": 3,
+          "startColumn": 0,
+          "endLine": 3,
+          "endColumn": 26
+        }
+      },
+      "secondaryLocations": [
+        {
+          "message": "This import belongs at line 3.",
+          "filePath": "example.proto",
+          "textRange": {
+            "startLine": 4,
+            "startColumn": 0
+          }
+        }
+      ]
+    },
+    {
+      "ruleId": "FILE_HAS_COMMENT",
+      "primaryLocation": {
+        "message": "File should have a comment",
+        "filePath": "example.proto"
+      }
+    }
+  ]
+}`,
+		},
+		{
+			name:          "Prints empty arrays without failures",
+			inputReporter: reporters.SonarV2Reporter{},
+			wantOutput: `{
+  "rules": [],
+  "issues": []
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := test.inputReporter.Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package reporters
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// Names of the sections of the template reporter.
+const (
+	templateSectionHeader  = "header"
+	templateSectionFailure = "failure"
+	templateSectionFooter  = "footer"
+)
+
+// TemplateReporter prints failures through a user-defined text/template.
+// The template can define the "header", "failure" and "footer" sections with {{define}}.
+// The header and the footer are executed once with the templateReport, and the failure section with each templateFailure.
+// A template without any sections is executed with each templateFailure, followed by a newline.
+type TemplateReporter struct {
+	tmpl *template.Template
+}
+
+// NewTemplateReporter creates a TemplateReporter without a template, which is given later by the config.
+func NewTemplateReporter() TemplateReporter {
+	return TemplateReporter{}
+}
+
+// NewTemplateReporterFromFile creates a TemplateReporter with the template in the file.
+func NewTemplateReporterFromFile(path string) (TemplateReporter, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return TemplateReporter{}, fmt.Errorf("failed to read the reporter template, err=%s", err)
+	}
+	return NewTemplateReporterFromString(string(content))
+}
+
+// NewTemplateReporterFromString creates a TemplateReporter with the template.
+func NewTemplateReporterFromString(text string) (TemplateReporter, error) {
+	tmpl, err := template.New("template").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return TemplateReporter{}, fmt.Errorf("failed to parse the reporter template, err=%s", err)
+	}
+	return TemplateReporter{tmpl: tmpl}, nil
+}
+
+// WithDefaultTemplate returns the reporter which uses the template unless it has one already.
+func (r TemplateReporter) WithDefaultTemplate(text string) (internalreport.Reporter, error) {
+	if r.tmpl != nil || text == "" {
+		return r, nil
+	}
+	reporter, err := NewTemplateReporterFromString(text)
+	if err != nil {
+		return nil, err
+	}
+	return reporter, nil
+}
+
+// templateFailure is the data of the failure section.
+type templateFailure struct {
+	Rule     string
+	Severity string
+	File     string
+	Line     int
+	Column   int
+	Message  string
+	Fixed    bool
+}
+
+// templateReport is the data of the header and the footer sections.
+type templateReport struct {
+	Failures []templateFailure
+	Total    int
+	Fixed    int
+	Errors   int
+	Warnings int
+	Notes    int
+	Files    int
+}
+
+var templateFuncs = template.FuncMap{
+	"upper":      strings.ToUpper,
+	"lower":      strings.ToLower,
+	"jsonEscape": jsonEscape,
+	"relpath":    relpath,
+	"severity":   mapSeverity,
+}
+
+// jsonEscape escapes the string to be put in a JSON string, without the quotes.
+func jsonEscape(s string) (string, error) {
+	bs, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(bs[1 : len(bs)-1]), nil
+}
+
+// relpath returns the last argument relative to the first one, which defaults to the working directory.
+// For example, {{ relpath .File }} and {{ .File | relpath "/path/to/repo" }}.
+func relpath(args ...string) (string, error) {
+	if len(args) == 0 || 2 < len(args) {
+		return "", fmt.Errorf("relpath takes a path and an optional base, but got %d arguments", len(args))
+	}
+	path := args[len(args)-1]
+	base := "."
+	if len(args) == 2 {
+		base = args[0]
+	}
+	absBase, err := filepath.Abs(base)
+	if err != nil {
+		return "", err
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(absBase, absPath)
+	if err != nil {
+		return path, nil
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// mapSeverity maps the severity, the last argument, with the preceding "from=to" pairs.
+// An unmapped severity is returned as it is.
+// For example, {{ .Severity | severity "error=major" "warning=minor" "note=info" }}.
+func mapSeverity(args ...string) (string, error) {
+	if len(args) == 0 {
+		return "", fmt.Errorf("severity takes the pairs and a severity, but got no arguments")
+	}
+	severity := args[len(args)-1]
+	for _, pair := range args[:len(args)-1] {
+		from, to, ok := strings.Cut(pair, "=")
+		if !ok {
+			return "", fmt.Errorf(`severity takes the pairs like "error=major", but got %q`, pair)
+		}
+		if from == severity {
+			return to, nil
+		}
+	}
+	return severity, nil
+}
+
+// Report writes failures to w.
+func (r TemplateReporter) Report(w io.Writer, fs []report.Failure) error {
+	if r.tmpl == nil {
+		return fmt.Errorf("the template reporter requires a template. Specify it with -reporter template:path/to/tmpl or reporter_template in the config file")
+	}
+
+	data := templateReport{
+		Total: len(fs),
+		Fixed: report.CountFixed(fs),
+	}
+	files := make(map[string]bool)
+	for _, f := range fs {
+		severity := normalizedSeverity(f.Severity())
+		switch rule.Severity(severity) {
+		case rule.SeverityWarning:
+			data.Warnings++
+		case rule.SeverityNote:
+			data.Notes++
+		default:
+			data.Errors++
+		}
+		files[f.Pos().Filename] = true
+		data.Failures = append(data.Failures, templateFailure{
+			Rule:     f.RuleID(),
+			Severity: severity,
+			File:     f.Pos().Filename,
+			Line:     f.Pos().Line,
+			Column:   f.Pos().Column,
+			Message:  f.Message(),
+			Fixed:    f.Fixed(),
+		})
+	}
+	data.Files = len(files)
+
+	header := r.tmpl.Lookup(templateSectionHeader)
+	failure := r.tmpl.Lookup(templateSectionFailure)
+	footer := r.tmpl.Lookup(templateSectionFooter)
+	if header == nil && failure == nil && footer == nil {
+		return r.reportLines(w, data.Failures)
+	}
+
+	if header != nil {
+		err := header.Execute(w, data)
+		if err != nil {
+			return err
+		}
+	}
+	if failure != nil {
+		for _, f := range data.Failures {
+			err := failure.Execute(w, f)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if footer != nil {
+		return footer.Execute(w, data)
+	}
+	return nil
+}
+
+// reportLines executes the template without sections for each failure like CiReporter.
+func (r TemplateReporter) reportLines(w io.Writer, fs []templateFailure) error {
+	for _, f := range fs {
+		var buf strings.Builder
+		err := r.tmpl.Execute(&buf, f)
+		if err != nil {
+			return err
+		}
+		if buf.Len() == 0 {
+			continue
+		}
+		_, err = fmt.Fprintln(w, buf.String())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
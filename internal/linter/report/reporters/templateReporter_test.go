@@ -0,0 +1,155 @@
+package reporters_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/internal/linter/report/reporters"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+func TestTemplateReporter_Report(t *testing.T) {
+	failures := []report.Failure{
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "example.proto",
+				Offset:   100,
+				Line:     5,
+				Column:   10,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			string(rule.SeverityError),
+			`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+		),
+		report.FailureWithSeverityf(
+			meta.Position{
+				Filename: "sub/example.proto",
+				Offset:   200,
+				Line:     10,
+				Column:   20,
+			},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			string(rule.SeverityWarning),
+			`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+		).WithFixed(true),
+	}
+
+	tests := []struct {
+		name          string
+		inputTemplate string
+		wantOutput    string
+		wantExistErr  bool
+	}{
+		{
+			name: "Prints the header, each failure and the footer",
+			inputTemplate: `{{define "header"}}{{.Total}} problems:
+{{end}}
+{{- define "failure"}}- {{.File}}:{{.Line}}:{{.Column}} {{.Rule}} {{.Severity}}{{if .Fixed}} fixed{{end}}
+{{end}}
+{{- define "footer"}}{{.Errors}} errors, {{.Warnings}} warnings, {{.Notes}} notes in {{.Files}} files, {{.Fixed}} fixed
+{{end}}`,
+			wantOutput: `2 problems:
+- example.proto:5:10 ENUM_NAMES_UPPER_CAMEL_CASE error
+- sub/example.proto:10:20 ENUM_NAMES_UPPER_CAMEL_CASE warning fixed
+1 errors, 1 warnings, 0 notes in 2 files, 1 fixed
+`,
+		},
+		{
+			name:          "Prints each failure in a line without the sections",
+			inputTemplate: `{{ .Severity | upper }} {{ .Rule | lower }}`,
+			wantOutput: `ERROR enum_names_upper_camel_case
+WARNING enum_names_upper_camel_case
+`,
+		},
+		{
+			name:          "Maps the severities, escapes the messages and makes the paths relative",
+			inputTemplate: `{"severity":"{{ .Severity | severity "error=major" "warning=minor" }}","path":"{{ .File | relpath "sub" }}","message":"{{ jsonEscape .Message }}"}`,
+			wantOutput: `{"severity":"major","path":"../example.proto","message":"EnumField name \"fIRST_VALUE\" must be CAPITALS_WITH_UNDERSCORES"}
+{"severity":"minor","path":"example.proto","message":"EnumField name \"SECOND.VALUE\" must be CAPITALS_WITH_UNDERSCORES"}
+`,
+		},
+		{
+			name:          "Fails with an invalid severity mapping",
+			inputTemplate: `{{ .Severity | severity "major" }}`,
+			wantExistErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			r, err := reporters.NewTemplateReporterFromString(test.inputTemplate)
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			buf := &bytes.Buffer{}
+			err = r.Report(buf, failures)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
+
+func TestTemplateReporter_WithDefaultTemplate(t *testing.T) {
+	failures := []report.Failure{
+		report.Failuref(
+			meta.Position{Filename: "example.proto", Line: 5, Column: 10},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			"message",
+		),
+	}
+
+	err := reporters.NewTemplateReporter().Report(&bytes.Buffer{}, failures)
+	if err == nil {
+		t.Errorf("got err nil without a template, but want err")
+	}
+
+	configured, err := reporters.NewTemplateReporter().WithDefaultTemplate("from config {{ .Rule }}")
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	buf := &bytes.Buffer{}
+	err = configured.Report(buf, failures)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	if buf.String() != "from config ENUM_NAMES_UPPER_CAMEL_CASE\n" {
+		t.Errorf("got %s, but want the template from the config", buf.String())
+	}
+
+	fromFlag, err := reporters.NewTemplateReporterFromString("from flag {{ .Rule }}")
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	kept, err := fromFlag.WithDefaultTemplate("from config {{ .Rule }}")
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	buf.Reset()
+	err = kept.Report(buf, failures)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	if buf.String() != "from flag ENUM_NAMES_UPPER_CAMEL_CASE\n" {
+		t.Errorf("got %s, but want the template from the flag", buf.String())
+	}
+
+	_, err = reporters.NewTemplateReporter().WithDefaultTemplate("{{ .Rule ")
+	if err == nil {
+		t.Errorf("got err nil with an invalid template, but want err")
+	}
+}
@@ -23,7 +23,7 @@ func getTscSeverity(s string) string {
 
 // Report writes failures to w.
 func (r TscReporter) Report(w io.Writer, fs []report.Failure) error {
-	for _, failure := range fs {
+	for _, failure := range report.Unfixed(fs) {
 		tsc_output := fmt.Sprintf(
 			"%s(%d,%d): %s %s: '%s'",
 			failure.Pos().Filename,
@@ -42,6 +42,17 @@ func TestTscReporter_Report(t *testing.T) {
 					"warning",
 					`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
 				),
+				report.FailureWithSeverityf(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   300,
+						Line:     15,
+						Column:   20,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					"error",
+					`EnumField name "third.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				).WithFixed(true),
 			},
 			wantOutput: `example.proto(5,10): error ENUM_NAMES_UPPER_CAMEL_CASE: 'EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES'
 example.proto(10,20): warning ENUM_NAMES_UPPER_CAMEL_CASE: 'EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES'
@@ -15,7 +15,7 @@ type UnixReporter struct{}
 
 // Report writes failures to w.
 func (r UnixReporter) Report(w io.Writer, fs []report.Failure) error {
-	for _, failure := range fs {
+	for _, failure := range report.Unfixed(fs) {
 		unix := fmt.Sprintf(
 			"%s: %s",
 			failure.Pos(),
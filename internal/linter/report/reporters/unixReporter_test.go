@@ -40,6 +40,16 @@ func TestUnixReporter_Report(t *testing.T) {
 					"ENUM_NAMES_UPPER_CAMEL_CASE",
 					`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
 				),
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   300,
+						Line:     15,
+						Column:   20,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					`EnumField name "third.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				).WithFixed(true),
 			},
 			wantOutput: `example.proto:5:10: EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES
 example.proto:10:20: EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES
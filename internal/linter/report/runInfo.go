@@ -0,0 +1,39 @@
+package report
+
+// FileStatus is the status of a file in a lint run.
+type FileStatus string
+
+const (
+	// FileStatusLinted means the file is parsed and linted.
+	FileStatusLinted FileStatus = "linted"
+	// FileStatusSkipped means the file isn't parsed because no rules apply to it.
+	FileStatusSkipped FileStatus = "skipped"
+	// FileStatusParseError means the file can't be parsed.
+	FileStatusParseError FileStatus = "parse_error"
+	// FileStatusError means the file can't be linted for any other reason, like a plugin error.
+	FileStatusError FileStatus = "error"
+)
+
+// FileResult is the result of a file in a lint run.
+type FileResult struct {
+	Path   string
+	Status FileStatus
+	// Error is the message of the error. It's empty unless the status is FileStatusParseError or FileStatusError.
+	Error string
+}
+
+// RunInfo describes a lint run.
+type RunInfo struct {
+	ToolVersion string
+	// ConfigPath is the path of the loaded config file. It's empty when no config file is loaded.
+	ConfigPath string
+	Files      []FileResult
+}
+
+// RunInfoReporter is a Reporter which can describe the lint run in addition to the failures.
+// A Reporter can optionally implement it.
+type RunInfoReporter interface {
+	Reporter
+	// WithRunInfo returns the reporter which describes the run with the info.
+	WithRunInfo(info RunInfo) Reporter
+}
@@ -0,0 +1,25 @@
+package report
+
+// SourceReader reads the content of a linted file by the path displayed in the failures.
+// It reads the in-memory content of a file, like the one read from stdin, which isn't on disk.
+type SourceReader func(path string) ([]byte, error)
+
+// SourcesReporter is a Reporter which shows the sources of the failures.
+// A Reporter can optionally implement it.
+type SourcesReporter interface {
+	Reporter
+	// WithSources returns the reporter which reads the sources with read.
+	WithSources(read SourceReader) Reporter
+}
+
+// WithSources returns the reporters which read the sources with read if they show them.
+func (ros ReportersWithOutput) WithSources(read SourceReader) ReportersWithOutput {
+	var newReporters ReportersWithOutput
+	for _, ro := range ros {
+		if r, ok := ro.reporter.(SourcesReporter); ok {
+			ro.reporter = r.WithSources(read)
+		}
+		newReporters = append(newReporters, ro)
+	}
+	return newReporters
+}
@@ -0,0 +1,161 @@
+package report
+
+import (
+	"io"
+	"os"
+
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+// StreamReporter is a Reporter which writes the failures of each file as soon as the file is linted,
+// instead of all the failures at the end.
+// A Reporter can optionally implement it. The others are adapted to it by AsStreamReporter.
+type StreamReporter interface {
+	Reporter
+	// ReportFile writes the failures of a linted file to w.
+	ReportFile(w io.Writer, fs []report.Failure) error
+	// Finish writes the end of the report to w after all files are linted.
+	Finish(w io.Writer) error
+}
+
+// batchReporter adapts a Reporter, which writes all the failures at once, to StreamReporter.
+// It collects the failures of the files and writes them on Finish.
+type batchReporter struct {
+	Reporter
+	failures []report.Failure
+}
+
+func (r *batchReporter) ReportFile(_ io.Writer, fs []report.Failure) error {
+	r.failures = append(r.failures, fs...)
+	return nil
+}
+
+func (r *batchReporter) Finish(w io.Writer) error {
+	return r.Reporter.Report(w, r.failures)
+}
+
+// AsStreamReporter returns r as a StreamReporter, which is adapted unless r streams already.
+func AsStreamReporter(r Reporter) StreamReporter {
+	if s, ok := r.(StreamReporter); ok {
+		return s
+	}
+	return &batchReporter{Reporter: r}
+}
+
+// Stream reports the failures of each file to the reporters as soon as the file is linted.
+type Stream struct {
+	outputs []streamOutput
+}
+
+type streamOutput struct {
+	reporter StreamReporter
+	w        io.Writer
+	// file is nil when w is the console.
+	file *lazyFile
+}
+
+// lazyFile is the target file of a reporter, which is opened on the first write.
+// Therefore, the file is left untouched when the reporter writes nothing, for example on Abort.
+type lazyFile struct {
+	path string
+	file *os.File
+}
+
+func (f *lazyFile) Write(p []byte) (int, error) {
+	err := f.open()
+	if err != nil {
+		return 0, err
+	}
+	return f.file.Write(p)
+}
+
+// open creates or truncates the file unless it's open already.
+func (f *lazyFile) open() error {
+	if f.file != nil {
+		return nil
+	}
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+	f.file = file
+	return nil
+}
+
+// NewStream starts the stream to the reporters, which write to w or their target files.
+// The reporters which don't stream write all the failures on Finish.
+// The target files are opened when the reporters write to them.
+func (ros ReportersWithOutput) NewStream(w io.Writer) *Stream {
+	s := &Stream{}
+	for _, ro := range ros {
+		output := streamOutput{
+			reporter: AsStreamReporter(ro.reporter),
+			w:        w,
+		}
+		if ro.targetFile != WriteToConsole {
+			output.file = &lazyFile{path: ro.targetFile}
+			output.w = output.file
+		}
+		s.outputs = append(s.outputs, output)
+	}
+	return s
+}
+
+// ReportFile reports the failures of a linted file.
+func (s *Stream) ReportFile(fs []report.Failure) error {
+	for _, o := range s.outputs {
+		err := o.reporter.ReportFile(o.w, fs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Finish finishes the reporters after all files are linted.
+// The reporters which don't stream are given the info to describe the run if they can.
+func (s *Stream) Finish(info RunInfo) error {
+	return s.finish(info, false)
+}
+
+// Abort finishes the reporters when linting stops at a file which can't be parsed.
+// Of the reporters which don't stream, only the ones which describe the run report the file,
+// while the others write nothing.
+func (s *Stream) Abort(info RunInfo) error {
+	return s.finish(info, true)
+}
+
+func (s *Stream) finish(info RunInfo, aborted bool) error {
+	for _, o := range s.outputs {
+		if b, ok := o.reporter.(*batchReporter); ok {
+			r, ok := b.Reporter.(RunInfoReporter)
+			if !ok && aborted {
+				continue
+			}
+			if ok {
+				b.Reporter = r.WithRunInfo(info)
+			}
+		}
+		err := o.reporter.Finish(o.w)
+		if err != nil {
+			return err
+		}
+		if o.file != nil {
+			// Replace the last report with an empty one, even if the reporter writes nothing.
+			err = o.file.open()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Close closes the target files of the reporters.
+func (s *Stream) Close() {
+	for _, o := range s.outputs {
+		if o.file != nil && o.file.file != nil {
+			_ = o.file.file.Close()
+		}
+	}
+}
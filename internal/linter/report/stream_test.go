@@ -0,0 +1,178 @@
+package report_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	internalreport "github.com/yoheimuta/protolint/internal/linter/report"
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+// countReporter writes the number of the failures and the files of the run if it's given.
+type countReporter struct {
+	files int
+}
+
+func (r countReporter) Report(w io.Writer, fs []report.Failure) error {
+	_, err := fmt.Fprintf(w, "failures=%d files=%d\n", len(fs), r.files)
+	return err
+}
+
+// emptyReporter writes nothing.
+type emptyReporter struct{}
+
+func (r emptyReporter) Report(io.Writer, []report.Failure) error {
+	return nil
+}
+
+type runInfoCountReporter struct {
+	countReporter
+}
+
+func (r runInfoCountReporter) WithRunInfo(info internalreport.RunInfo) internalreport.Reporter {
+	return runInfoCountReporter{countReporter{files: len(info.Files)}}
+}
+
+func TestStream(t *testing.T) {
+	failure := report.Failuref(meta.Position{Filename: "a.proto"}, "RULE", "message")
+	info := internalreport.RunInfo{
+		Files: []internalreport.FileResult{
+			{Path: "a.proto", Status: internalreport.FileStatusLinted},
+			{Path: "b.proto", Status: internalreport.FileStatusParseError},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		inputReporter internalreport.Reporter
+		inputAbort    bool
+		wantOutput    string
+	}{
+		{
+			name:          "A batch reporter writes all the failures on Finish",
+			inputReporter: countReporter{},
+			wantOutput:    "failures=2 files=0\n",
+		},
+		{
+			name:          "A batch reporter writes nothing on Abort",
+			inputReporter: countReporter{},
+			inputAbort:    true,
+		},
+		{
+			name:          "A batch reporter which describes the run is given the run info",
+			inputReporter: runInfoCountReporter{},
+			wantOutput:    "failures=2 files=2\n",
+		},
+		{
+			name:          "A batch reporter which describes the run writes on Abort",
+			inputReporter: runInfoCountReporter{},
+			inputAbort:    true,
+			wantOutput:    "failures=2 files=2\n",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			reporters := internalreport.ReportersWithOutput{
+				*internalreport.NewReporterWithOutput(test.inputReporter, internalreport.WriteToConsole),
+			}
+			stream := reporters.NewStream(buf)
+			defer stream.Close()
+
+			for i := 0; i < 2; i++ {
+				err := stream.ReportFile([]report.Failure{failure})
+				if err != nil {
+					t.Fatalf("got err %v, but want nil", err)
+				}
+			}
+			if buf.Len() != 0 {
+				t.Errorf("got %q before finishing, but want nothing", buf.String())
+			}
+
+			var err error
+			if test.inputAbort {
+				err = stream.Abort(info)
+			} else {
+				err = stream.Finish(info)
+			}
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %q, but want %q", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
+
+func TestStream_TargetFile(t *testing.T) {
+	failure := report.Failuref(meta.Position{Filename: "a.proto"}, "RULE", "message")
+
+	tests := []struct {
+		name          string
+		inputReporter internalreport.Reporter
+		inputAbort    bool
+		wantContent   string
+	}{
+		{
+			name:          "A batch reporter replaces the last report on Finish",
+			inputReporter: countReporter{},
+			wantContent:   "failures=1 files=0\n",
+		},
+		{
+			name:          "A batch reporter leaves the last report on Abort",
+			inputReporter: countReporter{},
+			inputAbort:    true,
+			wantContent:   "last report\n",
+		},
+		{
+			name:          "A reporter which writes nothing replaces the last report with an empty one",
+			inputReporter: emptyReporter{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "report.txt")
+			err := os.WriteFile(path, []byte("last report\n"), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			reporters := internalreport.ReportersWithOutput{
+				*internalreport.NewReporterWithOutput(test.inputReporter, path),
+			}
+			stream := reporters.NewStream(io.Discard)
+			defer stream.Close()
+
+			err = stream.ReportFile([]report.Failure{failure})
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			if got, _ := os.ReadFile(path); string(got) != "last report\n" {
+				t.Errorf("got %q before finishing, but want the last report", got)
+			}
+
+			if test.inputAbort {
+				err = stream.Abort(internalreport.RunInfo{})
+			} else {
+				err = stream.Finish(internalreport.RunInfo{})
+			}
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			if got, _ := os.ReadFile(path); string(got) != test.wantContent {
+				t.Errorf("got %q, but want %q", got, test.wantContent)
+			}
+		})
+	}
+}
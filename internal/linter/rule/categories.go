@@ -0,0 +1,87 @@
+package rule
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// builtinCategories are the categories which are known even if no rule has them.
+var builtinCategories = []string{
+	rule.CategoryNaming,
+	rule.CategoryStyle,
+	rule.CategoryDocumentation,
+	rule.CategoryCompatibility,
+	rule.CategoryLayout,
+}
+
+// Categories returns the categories of the rule, which come from rule.HasCategories.
+func Categories(r rule.HasApply) []string {
+	if c, ok := Unwrap(r).(rule.HasCategories); ok {
+		return c.Categories()
+	}
+	return nil
+}
+
+// IDsByCategory returns the rule ids keyed by the category.
+func (rs Rules) IDsByCategory() map[string][]string {
+	ids := make(map[string][]string)
+	for _, r := range rs {
+		for _, category := range Categories(r) {
+			ids[category] = append(ids[category], r.ID())
+		}
+	}
+	return ids
+}
+
+// WithCategorySeverities returns the severity overrides with the severities of the categories.
+// A rule takes the severity of its first category in categorySeverities,
+// unless overrides already has the severity of the rule.
+// It returns an error if categorySeverities has a category which neither the built-in rules nor the plugins know.
+func (rs Rules) WithCategorySeverities(
+	overrides map[string]rule.Severity,
+	categorySeverities map[string]rule.Severity,
+) (map[string]rule.Severity, error) {
+	if len(categorySeverities) == 0 {
+		return overrides, nil
+	}
+
+	known := make(map[string]bool)
+	for _, category := range builtinCategories {
+		known[category] = true
+	}
+	for category := range rs.IDsByCategory() {
+		known[category] = true
+	}
+	var unknown []string
+	for category := range categorySeverities {
+		if !known[category] {
+			unknown = append(unknown, category)
+		}
+	}
+	if 0 < len(unknown) {
+		var knownList []string
+		for category := range known {
+			knownList = append(knownList, category)
+		}
+		sort.Strings(unknown)
+		sort.Strings(knownList)
+		return nil, fmt.Errorf("category_severities got an unknown category %s. The known categories are %s", unknown[0], strings.Join(knownList, ", "))
+	}
+
+	newOverrides := make(map[string]rule.Severity)
+	for _, r := range rs {
+		for _, category := range Categories(r) {
+			if severity, ok := categorySeverities[category]; ok {
+				newOverrides[r.ID()] = severity
+				break
+			}
+		}
+	}
+	for id, severity := range overrides {
+		newOverrides[id] = severity
+	}
+	return newOverrides, nil
+}
@@ -0,0 +1,121 @@
+package rule_test
+
+import (
+	"reflect"
+	"testing"
+
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+type fakeRuleWithCategories struct {
+	fakeRule
+	categories []string
+}
+
+func (r fakeRuleWithCategories) Categories() []string { return r.categories }
+
+func TestCategories(t *testing.T) {
+	for _, test := range []struct {
+		name           string
+		inputRule      rule.Rule
+		wantCategories []string
+	}{
+		{
+			name:      "rule without categories",
+			inputRule: fakeRule{id: "RULE_A"},
+		},
+		{
+			name: "rule with categories",
+			inputRule: fakeRuleWithCategories{
+				fakeRule:   fakeRule{id: "RULE_A"},
+				categories: []string{"naming", "style"},
+			},
+			wantCategories: []string{"naming", "style"},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := internalrule.Categories(test.inputRule)
+			if !reflect.DeepEqual(got, test.wantCategories) {
+				t.Errorf("got %v, but want %v", got, test.wantCategories)
+			}
+		})
+	}
+}
+
+func TestRules_WithCategorySeverities(t *testing.T) {
+	rules := internalrule.Rules{
+		fakeRuleWithCategories{fakeRule: fakeRule{id: "RULE_A"}, categories: []string{"naming", "style"}},
+		fakeRuleWithCategories{fakeRule: fakeRule{id: "RULE_B"}, categories: []string{"style"}},
+		fakeRule{id: "RULE_C"},
+	}
+
+	got, err := rules.WithCategorySeverities(
+		map[string]rule.Severity{
+			"RULE_B": rule.SeverityError,
+		},
+		map[string]rule.Severity{
+			"style":  rule.SeverityNote,
+			"naming": rule.SeverityWarning,
+			// No rule has it, but it's a built-in category.
+			"layout": rule.SeverityError,
+		},
+	)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	want := map[string]rule.Severity{
+		"RULE_A": rule.SeverityWarning,
+		"RULE_B": rule.SeverityError,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+
+	wantIDs := map[string][]string{
+		"naming": {"RULE_A"},
+		"style":  {"RULE_A", "RULE_B"},
+	}
+	if gotIDs := rules.IDsByCategory(); !reflect.DeepEqual(gotIDs, wantIDs) {
+		t.Errorf("got %v, but want %v", gotIDs, wantIDs)
+	}
+}
+
+func TestRules_WithCategorySeveritiesUnknownCategory(t *testing.T) {
+	rules := internalrule.Rules{
+		fakeRuleWithCategories{fakeRule: fakeRule{id: "RULE_A"}, categories: []string{"custom"}},
+	}
+
+	for _, test := range []struct {
+		name          string
+		inputCategory string
+		wantExistErr  bool
+	}{
+		{
+			name:          "accept the category of a plugin rule",
+			inputCategory: "custom",
+		},
+		{
+			name:          "reject a misspelled category",
+			inputCategory: "namming",
+			wantExistErr:  true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := rules.WithCategorySeverities(nil, map[string]rule.Severity{
+				test.inputCategory: rule.SeverityNote,
+			})
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+			}
+		})
+	}
+}
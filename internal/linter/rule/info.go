@@ -0,0 +1,153 @@
+package rule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// Info describes a rule for the documentation, for example by `protolint explain`.
+type Info struct {
+	ID               string        `json:"id"`
+	Purpose          string        `json:"purpose"`
+	Description      string        `json:"description"`
+	Severity         rule.Severity `json:"severity"`
+	IsDefault        bool          `json:"is_default"`
+	Categories       []string      `json:"categories"`
+	DocumentationURL string        `json:"documentation_url,omitempty"`
+	Fixable          bool          `json:"fixable"`
+	AutoDisableable  bool          `json:"auto_disableable"`
+	Options          []rule.Option `json:"options"`
+	GoodExample      string        `json:"good_example,omitempty"`
+	BadExample       string        `json:"bad_example,omitempty"`
+}
+
+// readmeURL is the URL of the README, which documents every built-in rule at the anchor of its ID in lowercase.
+const readmeURL = "https://github.com/yoheimuta/protolint/blob/master/README.md"
+
+// NewInfo creates a new Info from the rule and the optional interfaces it implements.
+// The severity is the one of r, which may be overridden.
+func NewInfo(r rule.Rule) Info {
+	info := Info{
+		ID:         r.ID(),
+		Purpose:    r.Purpose(),
+		Severity:   r.Severity(),
+		IsDefault:  r.IsOfficial(),
+		Categories: Categories(r),
+		Options:    []rule.Option{},
+	}
+	if info.Categories == nil {
+		info.Categories = []string{}
+	}
+
+	// A rule overrides the documentation in the README by rule.HasDocumentationURL, like the plugin rules do.
+	original := Unwrap(r)
+	info.DocumentationURL = readmeURL + "#" + strings.ToLower(r.ID())
+	if d, ok := original.(rule.HasDocumentationURL); ok {
+		info.DocumentationURL = d.DocumentationURL()
+	}
+	if m, ok := original.(rule.HasMetadata); ok {
+		metadata := m.Metadata()
+		info.Description = metadata.Description
+		info.GoodExample = metadata.GoodExample
+		info.BadExample = metadata.BadExample
+		info.Fixable = metadata.Fixable
+		info.AutoDisableable = metadata.AutoDisableable
+		if 0 < len(metadata.Options) {
+			info.Options = metadata.Options
+		}
+	}
+	return info
+}
+
+// Infos returns the infos of the rules.
+func (rs Rules) Infos() []Info {
+	infos := make([]Info, 0, len(rs))
+	for _, r := range rs {
+		infos = append(infos, NewInfo(r))
+	}
+	return infos
+}
+
+// Find returns the rule with the id.
+func (rs Rules) Find(id string) (rule.Rule, bool) {
+	for _, r := range rs {
+		if r.ID() == id {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// Text returns the details of the rule in plain text, for example to show them in a terminal.
+func (i Info) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s\n", i.ID, i.Purpose)
+	if i.Description != "" {
+		fmt.Fprintf(&b, "\n%s\n", i.Description)
+	}
+	fmt.Fprintf(&b, "\nSeverity: %s\n", i.Severity)
+	fmt.Fprintf(&b, "Default: %t\n", i.IsDefault)
+	fmt.Fprintf(&b, "Fixable: %t\n", i.Fixable)
+	fmt.Fprintf(&b, "AutoDisableable: %t\n", i.AutoDisableable)
+	if 0 < len(i.Categories) {
+		fmt.Fprintf(&b, "Categories: %s\n", strings.Join(i.Categories, ", "))
+	}
+	if i.DocumentationURL != "" {
+		fmt.Fprintf(&b, "Documentation: %s\n", i.DocumentationURL)
+	}
+	if 0 < len(i.Options) {
+		fmt.Fprintf(&b, "\nOptions:\n")
+		for _, o := range i.Options {
+			fmt.Fprintf(&b, "  %s (default: %q)\n      %s\n", o.Name, o.Default, o.Description)
+		}
+	}
+	if i.BadExample != "" {
+		fmt.Fprintf(&b, "\nBad:\n%s\n", indent(i.BadExample, "  "))
+	}
+	if i.GoodExample != "" {
+		fmt.Fprintf(&b, "\nGood:\n%s\n", indent(i.GoodExample, "  "))
+	}
+	return b.String()
+}
+
+// Markdown returns the details of the rule in Markdown.
+func (i Info) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n\n%s\n", i.ID, i.Purpose)
+	if i.Description != "" {
+		fmt.Fprintf(&b, "\n%s\n", i.Description)
+	}
+	fmt.Fprintf(&b, "\n| Severity | Default | Fixable | AutoDisableable | Categories |\n")
+	fmt.Fprintf(&b, "|---|---|---|---|---|\n")
+	fmt.Fprintf(&b, "| %s | %t | %t | %t | %s |\n", i.Severity, i.IsDefault, i.Fixable, i.AutoDisableable, strings.Join(i.Categories, ", "))
+	if i.DocumentationURL != "" {
+		fmt.Fprintf(&b, "\nSee %s.\n", i.DocumentationURL)
+	}
+	if 0 < len(i.Options) {
+		fmt.Fprintf(&b, "\n### Options\n\n| Name | Default | Description |\n|---|---|---|\n")
+		for _, o := range i.Options {
+			def := "-"
+			if o.Default != "" {
+				def = "`" + o.Default + "`"
+			}
+			fmt.Fprintf(&b, "| `%s` | %s | %s |\n", o.Name, def, o.Description)
+		}
+	}
+	if i.BadExample != "" {
+		fmt.Fprintf(&b, "\n### Bad\n\n```proto\n%s\n```\n", i.BadExample)
+	}
+	if i.GoodExample != "" {
+		fmt.Fprintf(&b, "\n### Good\n\n```proto\n%s\n```\n", i.GoodExample)
+	}
+	return b.String()
+}
+
+func indent(s string, prefix string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
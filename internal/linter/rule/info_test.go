@@ -0,0 +1,116 @@
+package rule_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+type fakeRuleWithMetadata struct {
+	fakeRule
+}
+
+func (r fakeRuleWithMetadata) Categories() []string { return []string{"naming"} }
+func (r fakeRuleWithMetadata) Metadata() rule.Metadata {
+	return rule.Metadata{
+		Description: "description",
+		GoodExample: "good",
+		BadExample:  "bad",
+		Options: []rule.Option{
+			{Name: "suffix", Default: "UNSPECIFIED", Description: "suffix"},
+		},
+		Fixable: true,
+	}
+}
+
+type fakeRuleWithDocumentationURL struct {
+	fakeRule
+}
+
+func (r fakeRuleWithDocumentationURL) DocumentationURL() string { return "https://example.com/rule_c" }
+
+func TestNewInfo(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		inputRule rule.Rule
+		overrides map[string]rule.Severity
+		wantInfo  internalrule.Info
+	}{
+		{
+			name:      "rule without metadata",
+			inputRule: fakeRule{id: "RULE_A"},
+			wantInfo: internalrule.Info{
+				ID:               "RULE_A",
+				Severity:         rule.SeverityError,
+				IsDefault:        true,
+				Categories:       []string{},
+				DocumentationURL: "https://github.com/yoheimuta/protolint/blob/master/README.md#rule_a",
+				Options:          []rule.Option{},
+			},
+		},
+		{
+			name:      "rule with metadata and the overridden severity",
+			inputRule: fakeRuleWithMetadata{fakeRule{id: "RULE_B"}},
+			overrides: map[string]rule.Severity{
+				"RULE_B": rule.SeverityNote,
+			},
+			wantInfo: internalrule.Info{
+				ID:               "RULE_B",
+				Description:      "description",
+				Severity:         rule.SeverityNote,
+				IsDefault:        true,
+				Categories:       []string{"naming"},
+				DocumentationURL: "https://github.com/yoheimuta/protolint/blob/master/README.md#rule_b",
+				Fixable:          true,
+				Options: []rule.Option{
+					{Name: "suffix", Default: "UNSPECIFIED", Description: "suffix"},
+				},
+				GoodExample: "good",
+				BadExample:  "bad",
+			},
+		},
+		{
+			name:      "rule with the overridden documentation URL",
+			inputRule: fakeRuleWithDocumentationURL{fakeRule{id: "RULE_C"}},
+			wantInfo: internalrule.Info{
+				ID:               "RULE_C",
+				Severity:         rule.SeverityError,
+				IsDefault:        true,
+				Categories:       []string{},
+				DocumentationURL: "https://example.com/rule_c",
+				Options:          []rule.Option{},
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rules, err := internalrule.Rules{test.inputRule}.WithSeverityOverrides(test.overrides)
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+			got := rules.Infos()
+			if !reflect.DeepEqual(got, []internalrule.Info{test.wantInfo}) {
+				t.Errorf("got %v, but want %v", got, test.wantInfo)
+			}
+		})
+	}
+}
+
+func TestInfo_Markdown(t *testing.T) {
+	info := internalrule.NewInfo(fakeRuleWithMetadata{fakeRule{id: "RULE_B"}})
+	got := info.Markdown()
+	for _, want := range []string{
+		"## RULE_B\n",
+		"| error | true | true | false | naming |\n",
+		"| `suffix` | `UNSPECIFIED` | suffix |\n",
+		"### Bad\n\n```proto\nbad\n```\n",
+		"### Good\n\n```proto\ngood\n```\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("got %s, but want it to contain %s", got, want)
+		}
+	}
+}
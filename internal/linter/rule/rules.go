@@ -28,3 +28,15 @@ func ruleIDs(rules []rule.Rule) []string {
 	}
 	return ids
 }
+
+// Unwrap returns the original rule if the rule wraps another rule, for example to override the severity.
+// It's useful to check the optional interfaces the original rule implements.
+func Unwrap(r rule.HasApply) rule.HasApply {
+	for {
+		w, ok := r.(interface{ Unwrap() rule.Rule })
+		if !ok {
+			return r
+		}
+		r = w.Unwrap()
+	}
+}
@@ -0,0 +1,82 @@
+package rule
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/yoheimuta/protolint/internal/stringsutil"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// severityOverriddenRule is a rule whose severity is replaced.
+type severityOverriddenRule struct {
+	rule.Rule
+	severity rule.Severity
+}
+
+// Severity returns the overridden severity.
+func (r severityOverriddenRule) Severity() rule.Severity {
+	return r.severity
+}
+
+// Unwrap returns the original rule.
+func (r severityOverriddenRule) Unwrap() rule.Rule {
+	return r.Rule
+}
+
+// Apply applies the rule to the proto, and replaces the severity of the failures.
+func (r severityOverriddenRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	fs, err := r.Rule.Apply(proto)
+	if err != nil {
+		return nil, err
+	}
+	for i, f := range fs {
+		fs[i] = f.WithSeverity(string(r.severity))
+	}
+	return fs, nil
+}
+
+// WithSeverityOverrides returns the rules whose severities are replaced by the overrides keyed by the rule ID.
+// It works for both built-in and plugin rules. An unknown rule ID is an error, to catch a typo.
+func (rs Rules) WithSeverityOverrides(
+	overrides map[string]rule.Severity,
+) (Rules, error) {
+	if len(overrides) == 0 {
+		return rs, nil
+	}
+
+	var unknownIDs []string
+	ids := rs.IDs()
+	for id := range overrides {
+		if !stringsutil.ContainsStringInSlice(id, ids) {
+			unknownIDs = append(unknownIDs, id)
+		}
+	}
+	if 0 < len(unknownIDs) {
+		sort.Strings(unknownIDs)
+		return nil, fmt.Errorf("severity_overrides got an unknown rule ID %s. Run `protolint list` to see all rules", unknownIDs[0])
+	}
+
+	var newRules Rules
+	for _, r := range rs {
+		severity, ok := overrides[r.ID()]
+		if !ok {
+			newRules = append(newRules, r)
+			continue
+		}
+
+		switch severity {
+		case rule.SeverityError, rule.SeverityWarning, rule.SeverityNote:
+		default:
+			return nil, fmt.Errorf("%s is an invalid severity of %s. valid severity is error, warning or note", severity, r.ID())
+		}
+		newRules = append(newRules, severityOverriddenRule{
+			Rule:     r,
+			severity: severity,
+		})
+	}
+	return newRules, nil
+}
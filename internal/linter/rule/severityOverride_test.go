@@ -0,0 +1,96 @@
+package rule_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	internalrule "github.com/yoheimuta/protolint/internal/linter/rule"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+type fakeRule struct {
+	id string
+}
+
+func (r fakeRule) ID() string              { return r.id }
+func (r fakeRule) Purpose() string         { return "" }
+func (r fakeRule) IsOfficial() bool        { return true }
+func (r fakeRule) Severity() rule.Severity { return rule.SeverityError }
+func (r fakeRule) Apply(*parser.Proto) ([]report.Failure, error) {
+	return []report.Failure{
+		report.Failuref(meta.Position{Filename: "a.proto", Line: 1, Column: 1}, r.id, "failure"),
+	}, nil
+}
+
+func TestRules_WithSeverityOverrides(t *testing.T) {
+	for _, test := range []struct {
+		name           string
+		overrides      map[string]rule.Severity
+		wantSeverities []string
+		wantExistErr   bool
+	}{
+		{
+			name:           "no overrides",
+			wantSeverities: []string{"error", "error"},
+		},
+		{
+			name: "overrides the specified rule",
+			overrides: map[string]rule.Severity{
+				"RULE_B": rule.SeverityNote,
+			},
+			wantSeverities: []string{"error", "note"},
+		},
+		{
+			name: "unknown rule ID",
+			overrides: map[string]rule.Severity{
+				"RULE_B":  rule.SeverityNote,
+				"UNKNOWN": rule.SeverityWarning,
+			},
+			wantExistErr: true,
+		},
+		{
+			name: "invalid severity",
+			overrides: map[string]rule.Severity{
+				"RULE_A": "fatal",
+			},
+			wantExistErr: true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			rules := internalrule.Rules{
+				fakeRule{id: "RULE_A"},
+				fakeRule{id: "RULE_B"},
+			}
+			got, err := rules.WithSeverityOverrides(test.overrides)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+
+			var gotSeverities []string
+			for _, r := range got {
+				fs, err := r.Apply(nil)
+				if err != nil {
+					t.Fatalf("got err %v, but want nil", err)
+				}
+				if fs[0].Severity() != string(r.Severity()) {
+					t.Errorf("got failure severity %s, but want %s", fs[0].Severity(), r.Severity())
+				}
+				gotSeverities = append(gotSeverities, fs[0].Severity())
+			}
+			if !reflect.DeepEqual(gotSeverities, test.wantSeverities) {
+				t.Errorf("got %v, but want %v", gotSeverities, test.wantSeverities)
+			}
+		})
+	}
+}
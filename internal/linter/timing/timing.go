@@ -0,0 +1,200 @@
+package timing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Parse is the name of the step which parses a file.
+const Parse = "(parse)"
+
+// topFiles is the number of the slowest files in the text summary.
+const topFiles = 10
+
+// Stat is the time spent by a step.
+type Stat struct {
+	Name  string        `json:"name"`
+	Count int           `json:"count"`
+	Total time.Duration `json:"total_ns"`
+}
+
+// FileStat is the time spent on a file, broken down by the steps.
+type FileStat struct {
+	Stat
+	Steps []Stat `json:"steps"`
+}
+
+// Summary is the time spent, sorted from the slowest.
+type Summary struct {
+	Total time.Duration `json:"total_ns"`
+	// Steps are the parse step and the rules, summed up over all files.
+	Steps []Stat     `json:"steps"`
+	Files []FileStat `json:"files"`
+}
+
+type key struct {
+	file string
+	step string
+}
+
+// Recorder records the time spent by each step on each file.
+// It's safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	stats map[key]*Stat
+}
+
+// NewRecorder creates a new Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		stats: make(map[key]*Stat),
+	}
+}
+
+// Record adds the duration to the step on the file.
+func (r *Recorder) Record(
+	file string,
+	step string,
+	d time.Duration,
+) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := key{file: file, step: step}
+	s, ok := r.stats[k]
+	if !ok {
+		s = &Stat{Name: step}
+		r.stats[k] = s
+	}
+	s.Count++
+	s.Total += d
+}
+
+// Summary sums up the records.
+func (r *Recorder) Summary() Summary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	steps := make(map[string]*Stat)
+	files := make(map[string]*FileStat)
+	var total time.Duration
+	for k, s := range r.stats {
+		total += s.Total
+
+		step, ok := steps[k.step]
+		if !ok {
+			step = &Stat{Name: k.step}
+			steps[k.step] = step
+		}
+		step.Count += s.Count
+		step.Total += s.Total
+
+		file, ok := files[k.file]
+		if !ok {
+			file = &FileStat{Stat: Stat{Name: k.file}}
+			files[k.file] = file
+		}
+		file.Count += s.Count
+		file.Total += s.Total
+		file.Steps = append(file.Steps, *s)
+	}
+
+	summary := Summary{
+		Total: total,
+		Steps: []Stat{},
+		Files: []FileStat{},
+	}
+	for _, s := range steps {
+		summary.Steps = append(summary.Steps, *s)
+	}
+	sortStats(summary.Steps)
+	for _, f := range files {
+		sortStats(f.Steps)
+		summary.Files = append(summary.Files, *f)
+	}
+	sort.Slice(summary.Files, func(i, j int) bool {
+		return less(summary.Files[i].Stat, summary.Files[j].Stat)
+	})
+	return summary
+}
+
+func sortStats(stats []Stat) {
+	sort.Slice(stats, func(i, j int) bool {
+		return less(stats[i], stats[j])
+	})
+}
+
+// less sorts from the slowest, and by the name for the same duration.
+func less(a, b Stat) bool {
+	if a.Total != b.Total {
+		return a.Total > b.Total
+	}
+	return a.Name < b.Name
+}
+
+// WriteText writes the summary in a human-readable table.
+// It lists all steps and the slowest files.
+func (s Summary) WriteText(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "Timings (total %s):\n", round(s.Total))
+	if err != nil {
+		return err
+	}
+	err = writeStats(w, "Step", s.Steps, s.Total)
+	if err != nil {
+		return err
+	}
+
+	var files []Stat
+	for i, f := range s.Files {
+		if i == topFiles {
+			break
+		}
+		files = append(files, f.Stat)
+	}
+	_, err = fmt.Fprintln(w)
+	if err != nil {
+		return err
+	}
+	return writeStats(w, "File", files, s.Total)
+}
+
+func writeStats(
+	w io.Writer,
+	title string,
+	stats []Stat,
+	total time.Duration,
+) error {
+	_, err := fmt.Fprintf(w, "  %12s  %6s  %6s  %s\n", "Time", "%", "Count", title)
+	if err != nil {
+		return err
+	}
+	for _, s := range stats {
+		percent := 0.0
+		if 0 < total {
+			percent = float64(s.Total) * 100 / float64(total)
+		}
+		_, err := fmt.Fprintf(w, "  %12s  %5.1f%%  %6d  %s\n", round(s.Total), percent, s.Count, s.Name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Microsecond)
+}
+
+// WriteJSON writes the summary in JSON. The durations are in nanoseconds.
+func (s Summary) WriteJSON(w io.Writer) error {
+	bs, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", bs)
+	return err
+}
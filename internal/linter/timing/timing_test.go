@@ -0,0 +1,104 @@
+package timing_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yoheimuta/protolint/internal/linter/timing"
+)
+
+func newRecorder() *timing.Recorder {
+	r := timing.NewRecorder()
+	r.Record("a.proto", timing.Parse, 3*time.Millisecond)
+	r.Record("a.proto", "ORDER", 1*time.Millisecond)
+	r.Record("a.proto", timing.Parse, 3*time.Millisecond)
+	r.Record("a.proto", "INDENT", 2*time.Millisecond)
+	r.Record("b.proto", timing.Parse, 1*time.Millisecond)
+	r.Record("b.proto", "INDENT", 5*time.Millisecond)
+	return r
+}
+
+func TestRecorder_Summary(t *testing.T) {
+	got := newRecorder().Summary()
+
+	want := timing.Summary{
+		Total: 15 * time.Millisecond,
+		Steps: []timing.Stat{
+			{Name: timing.Parse, Count: 3, Total: 7 * time.Millisecond},
+			{Name: "INDENT", Count: 2, Total: 7 * time.Millisecond},
+			{Name: "ORDER", Count: 1, Total: 1 * time.Millisecond},
+		},
+		Files: []timing.FileStat{
+			{
+				Stat: timing.Stat{Name: "a.proto", Count: 4, Total: 9 * time.Millisecond},
+				Steps: []timing.Stat{
+					{Name: timing.Parse, Count: 2, Total: 6 * time.Millisecond},
+					{Name: "INDENT", Count: 1, Total: 2 * time.Millisecond},
+					{Name: "ORDER", Count: 1, Total: 1 * time.Millisecond},
+				},
+			},
+			{
+				Stat: timing.Stat{Name: "b.proto", Count: 2, Total: 6 * time.Millisecond},
+				Steps: []timing.Stat{
+					{Name: "INDENT", Count: 1, Total: 5 * time.Millisecond},
+					{Name: timing.Parse, Count: 1, Total: 1 * time.Millisecond},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+}
+
+func TestSummary_WriteText(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := newRecorder().Summary().WriteText(buf)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{
+		"Timings (total 15ms):\n",
+		"           7ms   46.7%       3  (parse)\n",
+		"           1ms    6.7%       1  ORDER\n",
+		"           9ms   60.0%       4  a.proto\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("got %s, but want it to contain %q", got, want)
+		}
+	}
+}
+
+func TestSummary_WriteJSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := newRecorder().Summary().WriteJSON(buf)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+
+	var got struct {
+		Total int64 `json:"total_ns"`
+		Files []struct {
+			Name  string `json:"name"`
+			Steps []struct {
+				Name string `json:"name"`
+			} `json:"steps"`
+		} `json:"files"`
+	}
+	err = json.Unmarshal(buf.Bytes(), &got)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+	if got.Total != int64(15*time.Millisecond) {
+		t.Errorf("got total %d, but want %d", got.Total, 15*time.Millisecond)
+	}
+	if len(got.Files) != 2 || got.Files[0].Name != "a.proto" || got.Files[0].Steps[0].Name != timing.Parse {
+		t.Errorf("got files %v, but want a.proto first", got.Files)
+	}
+}
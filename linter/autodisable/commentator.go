@@ -13,15 +13,11 @@ type commentator struct {
 	ruleID string
 }
 
-func newCommentator(filename, ruleID string) (*commentator, error) {
-	f, err := fixer.NewBaseFixing(filename)
-	if err != nil {
-		return nil, err
-	}
+func newCommentator(fixing *fixer.BaseFixing, ruleID string) *commentator {
 	return &commentator{
-		fixing: f,
+		fixing: fixing,
 		ruleID: ruleID,
-	}, nil
+	}
 }
 
 func (c *commentator) insertNewline(offset int) {
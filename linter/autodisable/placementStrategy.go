@@ -1,6 +1,10 @@
 package autodisable
 
-import "github.com/yoheimuta/go-protoparser/v4/parser"
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/yoheimuta/protolint/linter/fixer"
+)
 
 // PlacementType is a selection of the placement strategies.
 type PlacementType int
@@ -16,14 +20,33 @@ const (
 
 // NewPlacementStrategy creates a strategy object.
 func NewPlacementStrategy(ptype PlacementType, filename, ruleID string) (PlacementStrategy, error) {
+	return newPlacementStrategy(ptype, ruleID, func() (*fixer.BaseFixing, error) {
+		return fixer.NewBaseFixing(filename)
+	})
+}
+
+// NewPlacementStrategyFromProto creates a strategy object which puts comments to the file the proto is parsed from.
+// Unlike NewPlacementStrategy, it works on the content of the file which lives only in memory.
+func NewPlacementStrategyFromProto(ptype PlacementType, proto *parser.Proto, ruleID string) (PlacementStrategy, error) {
+	return newPlacementStrategy(ptype, ruleID, func() (*fixer.BaseFixing, error) {
+		return fixer.NewBaseFixingFromProto(proto)
+	})
+}
+
+func newPlacementStrategy(
+	ptype PlacementType,
+	ruleID string,
+	newFixing func() (*fixer.BaseFixing, error),
+) (PlacementStrategy, error) {
 	if ptype == Noop {
 		return &noopPlacementStrategy{}, nil
 	}
 
-	c, err := newCommentator(filename, ruleID)
+	fixing, err := newFixing()
 	if err != nil {
 		return nil, err
 	}
+	c := newCommentator(fixing, ruleID)
 	switch ptype {
 	case ThisThenNext:
 		return newThisThenNextPlacementStrategy(c), err
@@ -2,7 +2,6 @@ package fixer
 
 import (
 	"bytes"
-	"io/ioutil"
 	"strings"
 
 	"github.com/yoheimuta/go-protoparser/v4/lexer"
@@ -10,7 +9,7 @@ import (
 
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
-	"github.com/yoheimuta/protolint/internal/osutil"
+	"github.com/yoheimuta/protolint/internal/linter/file"
 )
 
 // TextEdit represents the replacement of the code between Pos and End with the new text.
@@ -41,7 +40,7 @@ type Fixing interface {
 // NewFixing creates a fixing, depending on fixMode.
 func NewFixing(fixMode bool, proto *parser.Proto) (Fixing, error) {
 	if fixMode {
-		return NewBaseFixing(proto.Meta.Filename)
+		return NewBaseFixingFromProto(proto)
 	}
 	return NopFixing{}, nil
 }
@@ -50,13 +49,23 @@ func NewFixing(fixMode bool, proto *parser.Proto) (Fixing, error) {
 type BaseFixing struct {
 	content    []byte
 	lineEnding string
-	fileName   string
+	file       file.ProtoFile
 	textEdits  []TextEdit
 }
 
 // NewBaseFixing creates a BaseFixing.
 func NewBaseFixing(protoFileName string) (*BaseFixing, error) {
-	content, err := ioutil.ReadFile(protoFileName)
+	return newBaseFixing(file.FromFilename(protoFileName))
+}
+
+// NewBaseFixingFromProto creates a BaseFixing of the file which the proto is parsed from.
+// Unlike NewBaseFixing, it works on the content of the file which lives only in memory, like the one read from stdin.
+func NewBaseFixingFromProto(proto *parser.Proto) (*BaseFixing, error) {
+	return newBaseFixing(file.FromProto(proto))
+}
+
+func newBaseFixing(f file.ProtoFile) (*BaseFixing, error) {
+	content, err := f.Content()
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +80,7 @@ func NewBaseFixing(protoFileName string) (*BaseFixing, error) {
 	return &BaseFixing{
 		content:    content,
 		lineEnding: lineEnding,
-		fileName:   protoFileName,
+		file:       f,
 	}, nil
 }
 
@@ -124,7 +133,7 @@ func (f *BaseFixing) Finally() error {
 		f.content = append(f.content[:t.Pos], append(t.NewText, f.content[t.End+1:]...)...)
 		diff += len(t.NewText) - (t.End - t.Pos + 1)
 	}
-	return osutil.WriteExistingFile(f.fileName, f.content)
+	return f.file.WriteContent(f.content)
 }
 
 // Replace records a textedit to replace the old with the next later.
@@ -12,10 +12,19 @@ const errorLevel = "error"
 
 // Failure represents a lint error information.
 type Failure struct {
-	pos      meta.Position
-	message  string
-	ruleID   string
-	severity string
+	pos                meta.Position
+	end                meta.Position
+	message            string
+	ruleID             string
+	severity           string
+	fixed              bool
+	secondaryLocations []Location
+}
+
+// Location is a position which explains a failure, like the other declaration conflicting with it.
+type Location struct {
+	Pos     meta.Position
+	Message string
 }
 
 // Failuref creates a new Failure and the formatting works like fmt.Sprintf.
@@ -65,6 +74,33 @@ func (f Failure) Pos() meta.Position {
 	return f.pos
 }
 
+// End returns the position just after the last character of the failure.
+// It's the zero value unless the rule knows the end.
+func (f Failure) End() meta.Position {
+	return f.end
+}
+
+// WithEnd returns a copy of Failure ending just before the position.
+func (f Failure) WithEnd(end meta.Position) Failure {
+	f.end = end
+	return f
+}
+
+// SecondaryLocations returns the other locations which explain the failure.
+func (f Failure) SecondaryLocations() []Location {
+	return f.secondaryLocations
+}
+
+// WithSecondaryLocations returns a copy of Failure with the locations which explain it.
+func (f Failure) WithSecondaryLocations(locations ...Location) Failure {
+	if len(locations) == 0 {
+		f.secondaryLocations = nil
+		return f
+	}
+	f.secondaryLocations = append([]Location{}, locations...)
+	return f
+}
+
 // RuleID returns a rule ID.
 func (f Failure) RuleID() string {
 	return f.ruleID
@@ -75,6 +111,46 @@ func (f Failure) Severity() string {
 	return f.severity
 }
 
+// WithSeverity returns a copy of Failure with the severity.
+func (f Failure) WithSeverity(severity string) Failure {
+	f.severity = severity
+	return f
+}
+
+// Fixed reports whether the failure was fixed by -fix.
+func (f Failure) Fixed() bool {
+	return f.fixed
+}
+
+// WithFixed returns a copy of Failure marked as fixed or not.
+func (f Failure) WithFixed(fixed bool) Failure {
+	f.fixed = fixed
+	return f
+}
+
+// Unfixed returns the failures which aren't fixed.
+// The machine-readable reports leave the fixed ones out, because their consumers would show them as open issues.
+func Unfixed(fs []Failure) []Failure {
+	var unfixed []Failure
+	for _, f := range fs {
+		if !f.fixed {
+			unfixed = append(unfixed, f)
+		}
+	}
+	return unfixed
+}
+
+// CountFixed counts the fixed failures.
+func CountFixed(fs []Failure) int {
+	n := 0
+	for _, f := range fs {
+		if f.fixed {
+			n++
+		}
+	}
+	return n
+}
+
 // FilenameWithoutExt returns a filename without the extension.
 func (f Failure) FilenameWithoutExt() string {
 	name := f.pos.Filename
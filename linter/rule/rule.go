@@ -49,6 +49,75 @@ type HasSeverity interface {
 	Severity() Severity
 }
 
+// The categories of the built-in rules. A plugin rule can use them or its own ones.
+const (
+	// CategoryNaming is a category of the rules about the names.
+	CategoryNaming = "naming"
+	// CategoryStyle is a category of the rules about the formatting.
+	CategoryStyle = "style"
+	// CategoryDocumentation is a category of the rules about the comments.
+	CategoryDocumentation = "documentation"
+	// CategoryCompatibility is a category of the rules about the compatibility of the schema.
+	CategoryCompatibility = "compatibility"
+	// CategoryLayout is a category of the rules about the structure of the file.
+	CategoryLayout = "layout"
+)
+
+// HasCategories represents a rule with categories, which can be used to enable the rules and set the severities together.
+// A rule can optionally implement it.
+type HasCategories interface {
+	// Categories returns the categories of this rule, for example "naming" and "style".
+	Categories() []string
+}
+
+// HasDocumentationURL represents a rule with a documentation.
+// A rule can optionally implement it.
+type HasDocumentationURL interface {
+	// DocumentationURL returns the URL of the documentation of this rule.
+	DocumentationURL() string
+}
+
+// HasIsDeterministic represents a rule which declares whether or not it's deterministic.
+// A rule can optionally implement it. A built-in rule is always deterministic.
+type HasIsDeterministic interface {
+	// IsDeterministic decides whether or not this rule always reports the same failures for the same content,
+	// which allows caching them.
+	IsDeterministic() bool
+}
+
+// Option describes a configurable option of a rule.
+type Option struct {
+	// Name is the key in the rule option of .protolint.yaml.
+	Name string `json:"name"`
+	// Default is the default value in the YAML format.
+	Default string `json:"default"`
+	// Description explains the option.
+	Description string `json:"description"`
+}
+
+// Metadata describes a rule in detail for the documentation.
+type Metadata struct {
+	// Description explains the rule in more detail than the purpose.
+	Description string
+	// GoodExample is an example which the rule accepts.
+	GoodExample string
+	// BadExample is an example which the rule reports.
+	BadExample string
+	// Options are the options configurable in .protolint.yaml.
+	Options []Option
+	// Fixable decides whether or not the rule fixes the failures with -fix.
+	Fixable bool
+	// AutoDisableable decides whether or not the rule inserts disable comments with -auto_disable.
+	AutoDisableable bool
+}
+
+// HasMetadata represents a rule with Metadata.
+// A rule can optionally implement it.
+type HasMetadata interface {
+	// Metadata returns the details of this rule.
+	Metadata() Metadata
+}
+
 // Rule represents a rule which a linter can apply.
 type Rule interface {
 	HasApply
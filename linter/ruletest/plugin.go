@@ -0,0 +1,51 @@
+package ruletest
+
+import (
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin"
+	"github.com/yoheimuta/protolint/internal/addon/plugin/shared"
+	"github.com/yoheimuta/protolint/internal/cmd/subcmds"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// Plugin starts the plugin that serves rules by plugin.RegisterCustomRules.
+// It returns a function that creates the rule with ruleID through the plugin, which can be passed to RunWithFix.
+// The command is the same as the one given to the -plugin flag. The plugin is killed when the test finishes.
+func Plugin(
+	t *testing.T,
+	command string,
+	ruleID string,
+) func(fixMode bool) rule.HasApply {
+	t.Helper()
+
+	var pf subcmds.PluginFlag
+	_ = pf.Set(command)
+	ruleSets, err := pf.BuildPlugins(false, subcmds.DefaultPluginTimeout)
+	if err != nil {
+		t.Fatalf("failed to start plugin %q, err=%v", command, err)
+	}
+	t.Cleanup(func() {
+		for _, r := range ruleSets {
+			if s, ok := r.(*shared.SupervisedRuleSet); ok {
+				s.Kill()
+			}
+		}
+	})
+
+	return func(fixMode bool) rule.HasApply {
+		t.Helper()
+
+		rules, err := plugin.GetExternalRules(ruleSets, fixMode, nil, false, false)
+		if err != nil {
+			t.Fatalf("failed to list rules of plugin %q, err=%v", command, err)
+		}
+		for _, r := range rules {
+			if r.ID() == ruleID {
+				return r
+			}
+		}
+		t.Fatalf("not found rule=%s in plugin %q", ruleID, command)
+		return nil
+	}
+}
@@ -0,0 +1,155 @@
+// Package ruletest provides a test harness for authors of rules, including the rules served by plugins.
+//
+// A test case is a directory of .proto files. Each file annotates the failures it expects
+// with trailing want markers:
+//
+//	message Foo {
+//	  string SongName = 1; // want "FIELD_NAMES_LOWER_SNAKE_CASE"
+//	}
+//
+// A quoted pattern matches a failure at the same line when it equals the rule ID,
+// or when it matches the message as a regular expression.
+// Put one pattern for each expected failure on the line.
+//
+// A .proto file can have the fixed content in the file with the additional .golden extension,
+// for example foo.proto.golden for foo.proto.
+package ruletest
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/linter/file"
+	"github.com/yoheimuta/protolint/linter/report"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+const goldenExt = ".golden"
+
+// Run applies the rule to each .proto file in dir and checks the failures against the want markers.
+func Run(
+	t *testing.T,
+	dir string,
+	r rule.HasApply,
+) {
+	t.Helper()
+
+	for _, path := range protoFiles(t, dir) {
+		path := path
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wants, err := parseWants(string(content))
+			if err != nil {
+				t.Fatalf("%s: %v", path, err)
+			}
+
+			failures, err := apply(path, r)
+			if err != nil {
+				t.Fatalf("%s: got err %v, but want nil", path, err)
+			}
+			for _, problem := range checkFailures(path, failures, wants) {
+				t.Error(problem)
+			}
+		})
+	}
+}
+
+// RunWithFix does the same as Run with the rule created by newRule(false).
+// Then, for each .proto file that has a .golden file, it applies the rule created by newRule(true)
+// to a copy of the .proto file, and checks that the fixed content is the same as the .golden file.
+// It also checks that fixing the fixed content again changes nothing.
+func RunWithFix(
+	t *testing.T,
+	dir string,
+	newRule func(fixMode bool) rule.HasApply,
+) {
+	t.Helper()
+
+	Run(t, dir, newRule(false))
+
+	for _, path := range protoFiles(t, dir) {
+		path := path
+		golden, err := os.ReadFile(path + goldenExt)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run(filepath.Base(path)+"/fix", func(t *testing.T) {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Keep the basename because some rules depend on it.
+			fixPath := filepath.Join(t.TempDir(), filepath.Base(path))
+			err = os.WriteFile(fixPath, content, 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fixed, err := fix(fixPath, newRule(true))
+			if err != nil {
+				t.Fatalf("%s: got err %v, but want nil", path, err)
+			}
+			if !bytes.Equal(fixed, golden) {
+				t.Fatalf("%s: got fixed content\n%s\n, but want\n%s", path, fixed, golden)
+			}
+
+			refixed, err := fix(fixPath, newRule(true))
+			if err != nil {
+				t.Fatalf("%s: got err %v on the second fix, but want nil", path, err)
+			}
+			if !bytes.Equal(refixed, fixed) {
+				t.Errorf("%s: the fix isn't idempotent, got\n%s\n, but want\n%s", path, refixed, fixed)
+			}
+		})
+	}
+}
+
+func protoFiles(
+	t *testing.T,
+	dir string,
+) []string {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("not found .proto files in %s", dir)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func apply(
+	path string,
+	r rule.HasApply,
+) ([]report.Failure, error) {
+	proto, err := file.NewProtoFile(path, path).Parse(false)
+	if err != nil {
+		return nil, err
+	}
+	return r.Apply(proto)
+}
+
+// fix applies the rule in fix mode, which rewrites the file at path, and returns the new content.
+func fix(
+	path string,
+	r rule.HasApply,
+) ([]byte, error) {
+	_, err := apply(path, r)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
@@ -0,0 +1,39 @@
+package ruletest_test
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/yoheimuta/protolint/internal/addon/rules"
+	"github.com/yoheimuta/protolint/internal/setting_test"
+	"github.com/yoheimuta/protolint/linter/autodisable"
+	"github.com/yoheimuta/protolint/linter/rule"
+	"github.com/yoheimuta/protolint/linter/ruletest"
+)
+
+func TestRunWithFix(t *testing.T) {
+	ruletest.RunWithFix(
+		t,
+		setting_test.TestDataPath("ruletest", "fieldnameslowersnakecase"),
+		func(fixMode bool) rule.HasApply {
+			return rules.NewFieldNamesLowerSnakeCaseRule(rule.SeverityError, fixMode, autodisable.Noop)
+		},
+	)
+}
+
+func TestPlugin(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("skip because go isn't installed")
+	}
+	path := filepath.Join(t.TempDir(), "plugin")
+	if out, err := exec.Command("go", "build", "-o", path, "./testdata/plugin").CombinedOutput(); err != nil {
+		t.Fatalf("failed to build the plugin, err=%v, out=%s", err, out)
+	}
+
+	ruletest.RunWithFix(
+		t,
+		setting_test.TestDataPath("ruletest", "fieldnameslowersnakecase"),
+		ruletest.Plugin(t, path, "FIELD_NAMES_LOWER_SNAKE_CASE"),
+	)
+}
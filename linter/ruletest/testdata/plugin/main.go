@@ -0,0 +1,21 @@
+// Command plugin is the minimal plugin for the tests of ruletest.Plugin.
+// It serves FIELD_NAMES_LOWER_SNAKE_CASE, which can fix the files.
+package main
+
+import (
+	"github.com/yoheimuta/protolint/internal/addon/rules"
+	"github.com/yoheimuta/protolint/linter/autodisable"
+	"github.com/yoheimuta/protolint/linter/rule"
+	"github.com/yoheimuta/protolint/plugin"
+)
+
+func main() {
+	plugin.RegisterCustomRules(
+		plugin.RuleGen(func(
+			verbose bool,
+			fixMode bool,
+		) rule.Rule {
+			return rules.NewFieldNamesLowerSnakeCaseRule(rule.SeverityError, fixMode, autodisable.Noop)
+		}),
+	)
+}
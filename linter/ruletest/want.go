@@ -0,0 +1,108 @@
+package ruletest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+const wantMarker = "// want "
+
+// want represents an expected failure annotated in a .proto file.
+type want struct {
+	line    int
+	pattern string
+	re      *regexp.Regexp
+}
+
+// matches decides whether or not the failure is the expected one.
+// A pattern matches either the rule ID itself or the message as a regular expression.
+func (w want) matches(f report.Failure) bool {
+	if f.Pos().Line != w.line {
+		return false
+	}
+	return w.pattern == f.RuleID() || w.re.MatchString(f.Message())
+}
+
+// parseWants collects the want markers in the content.
+// A marker is a trailing comment like `// want "RULE_ID" "message pattern"`, which expects
+// one failure per quoted pattern at the same line.
+func parseWants(content string) ([]want, error) {
+	var wants []want
+	for i, line := range strings.Split(content, "\n") {
+		idx := strings.Index(line, wantMarker)
+		if idx < 0 {
+			continue
+		}
+
+		rest := strings.TrimSpace(line[idx+len(wantMarker):])
+		for 0 < len(rest) {
+			quoted, err := strconv.QuotedPrefix(rest)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid want marker %q, err=%s", i+1, rest, err)
+			}
+			pattern, err := strconv.Unquote(quoted)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid want marker %q, err=%s", i+1, quoted, err)
+			}
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid want pattern %q, err=%s", i+1, pattern, err)
+			}
+
+			wants = append(wants, want{
+				line:    i + 1,
+				pattern: pattern,
+				re:      re,
+			})
+			rest = strings.TrimSpace(rest[len(quoted):])
+		}
+	}
+	return wants, nil
+}
+
+// checkFailures returns the problems found by matching the failures against the wants.
+func checkFailures(
+	displayPath string,
+	failures []report.Failure,
+	wants []want,
+) []string {
+	var problems []string
+
+	matched := make([]bool, len(wants))
+	for _, f := range failures {
+		found := false
+		for i, w := range wants {
+			if !matched[i] && w.matches(f) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			problems = append(problems, fmt.Sprintf(
+				"%s:%d:%d: unexpected failure %s: %s",
+				displayPath,
+				f.Pos().Line,
+				f.Pos().Column,
+				f.RuleID(),
+				f.Message(),
+			))
+		}
+	}
+
+	for i, w := range wants {
+		if !matched[i] {
+			problems = append(problems, fmt.Sprintf(
+				"%s:%d: no failure was reported matching %q",
+				displayPath,
+				w.line,
+				w.pattern,
+			))
+		}
+	}
+	return problems
+}
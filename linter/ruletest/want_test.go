@@ -0,0 +1,68 @@
+package ruletest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/yoheimuta/protolint/linter/report"
+)
+
+func TestCheckFailures(t *testing.T) {
+	content := `syntax = "proto3";
+message Foo { // want "MESSAGE_RULE" "must be .+"
+  string Bar = 1; // want "FIELD_RULE"
+}
+`
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		wantProblems  []string
+	}{
+		{
+			name: "all failures are expected",
+			inputFailures: []report.Failure{
+				report.Failuref(meta.Position{Line: 2, Column: 1}, "MESSAGE_RULE", "Message name is wrong"),
+				report.Failuref(meta.Position{Line: 2, Column: 9}, "OTHER_RULE", "Foo must be Bar"),
+				report.Failuref(meta.Position{Line: 3, Column: 3}, "FIELD_RULE", "Field name is wrong"),
+			},
+		},
+		{
+			name: "unexpected and missing failures",
+			inputFailures: []report.Failure{
+				report.Failuref(meta.Position{Line: 2, Column: 1}, "MESSAGE_RULE", "Message name is wrong"),
+				report.Failuref(meta.Position{Line: 3, Column: 3}, "MESSAGE_RULE", "Message name is wrong"),
+				report.Failuref(meta.Position{Line: 4, Column: 1}, "FIELD_RULE", "Field name is wrong"),
+			},
+			wantProblems: []string{
+				"foo.proto:3:3: unexpected failure MESSAGE_RULE: Message name is wrong",
+				"foo.proto:4:1: unexpected failure FIELD_RULE: Field name is wrong",
+				`foo.proto:2: no failure was reported matching "must be .+"`,
+				`foo.proto:3: no failure was reported matching "FIELD_RULE"`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			wants, err := parseWants(content)
+			if err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+
+			got := checkFailures("foo.proto", test.inputFailures, wants)
+			if !reflect.DeepEqual(got, test.wantProblems) {
+				t.Errorf("got %v, but want %v", got, test.wantProblems)
+			}
+		})
+	}
+}
+
+func TestParseWants(t *testing.T) {
+	_, err := parseWants(`string Bar = 1; // want FIELD_RULE`)
+	if err == nil {
+		t.Errorf("got err nil, but want err for an unquoted pattern")
+	}
+}
@@ -34,7 +34,22 @@ func (v *BaseAddVisitor) AddFailuref(
 	format string,
 	a ...interface{},
 ) {
-	v.failures = append(v.failures, report.FailureWithSeverityf(pos, v.ruleID, v.severity, format, a...))
+	v.AddFailure(v.Failuref(pos, format, a...))
+}
+
+// Failuref creates a failure of the rule without adding it, so that the end and the secondary locations can be set.
+// The formatting works like fmt.Sprintf.
+func (v *BaseAddVisitor) Failuref(
+	pos meta.Position,
+	format string,
+	a ...interface{},
+) report.Failure {
+	return report.FailureWithSeverityf(pos, v.ruleID, v.severity, format, a...)
+}
+
+// AddFailure adds the failure to the internal buffer.
+func (v *BaseAddVisitor) AddFailure(failure report.Failure) {
+	v.failures = append(v.failures, failure)
 }
 
 // AddFailurefWithProtoMeta adds to the internal buffer and the formatting works like fmt.Sprintf.
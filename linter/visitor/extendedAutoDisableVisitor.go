@@ -14,10 +14,10 @@ type extendedAutoDisableVisitor struct {
 func newExtendedAutoDisableVisitor(
 	inner HasExtendedVisitor,
 	ruleID string,
-	protoFilename string,
+	proto *parser.Proto,
 	placementType autodisable.PlacementType,
 ) (*extendedAutoDisableVisitor, error) {
-	automator, err := autodisable.NewPlacementStrategy(placementType, protoFilename, ruleID)
+	automator, err := autodisable.NewPlacementStrategyFromProto(placementType, proto, ruleID)
 	if err != nil {
 		return nil, err
 	}
@@ -35,12 +35,7 @@ func RunVisitorAutoDisable(
 	ruleID string,
 	autodisableType autodisable.PlacementType,
 ) ([]report.Failure, error) {
-	// This check is just for existing test cases.
-	protoFilename := ""
-	if proto.Meta != nil {
-		protoFilename = proto.Meta.Filename
-	}
-	autoDisabled, err := newExtendedAutoDisableVisitor(visitor, ruleID, protoFilename, autodisableType)
+	autoDisabled, err := newExtendedAutoDisableVisitor(visitor, ruleID, proto, autodisableType)
 	if err != nil {
 		return nil, err
 	}
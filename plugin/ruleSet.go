@@ -1,10 +1,14 @@
 package plugin
 
 import (
+	"bytes"
 	"fmt"
 
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
 	"github.com/yoheimuta/protolint/internal/addon/plugin/proto"
 	"github.com/yoheimuta/protolint/internal/linter/file"
+	"github.com/yoheimuta/protolint/internal/stringsutil"
 	"github.com/yoheimuta/protolint/linter/rule"
 )
 
@@ -26,11 +30,17 @@ func (c *ruleSet) initialize(req *proto.ListRulesRequest) {
 
 	ruleMap := make(map[string]rule.Rule)
 	for _, r := range c.rawRules {
-		if f, ok := r.(RuleGen); ok {
-			r = f(
+		if gen, ok := r.(RuleGen); ok {
+			r = gen(
 				req.Verbose,
 				req.FixMode,
 			)
+			if req.FixMode && 0 < len(req.FixOnly) && !stringsutil.ContainsStringInSlice(r.ID(), req.FixOnly) {
+				r = gen(
+					req.Verbose,
+					false,
+				)
+			}
 		}
 		ruleMap[r.ID()] = r
 	}
@@ -42,11 +52,38 @@ func (c *ruleSet) ListRules(req *proto.ListRulesRequest) (*proto.ListRulesRespon
 
 	var meta []*proto.ListRulesResponse_Rule
 	for _, r := range c.rules {
-		meta = append(meta, &proto.ListRulesResponse_Rule{
-			Id:       r.ID(),
-			Purpose:  r.Purpose(),
-			Severity: getSeverity(r.Severity()),
-		})
+		isDefault := r.IsOfficial()
+		m := &proto.ListRulesResponse_Rule{
+			Id:        r.ID(),
+			Purpose:   r.Purpose(),
+			Severity:  getSeverity(r.Severity()),
+			IsDefault: &isDefault,
+		}
+		if c, ok := r.(rule.HasCategories); ok {
+			m.Categories = c.Categories()
+		}
+		if d, ok := r.(rule.HasDocumentationURL); ok {
+			m.DocumentationUrl = d.DocumentationURL()
+		}
+		if d, ok := r.(rule.HasIsDeterministic); ok {
+			m.Deterministic = d.IsDeterministic()
+		}
+		if d, ok := r.(rule.HasMetadata); ok {
+			md := d.Metadata()
+			m.Description = md.Description
+			m.GoodExample = md.GoodExample
+			m.BadExample = md.BadExample
+			for _, o := range md.Options {
+				m.Options = append(m.Options, &proto.ListRulesResponse_Rule_Option{
+					Name:        o.Name,
+					Default:     o.Default,
+					Description: o.Description,
+				})
+			}
+			m.Fixable = md.Fixable
+			m.AutoDisableable = md.AutoDisableable
+		}
+		meta = append(meta, m)
 	}
 	return &proto.ListRulesResponse{
 		Rules: meta,
@@ -73,28 +110,58 @@ func (c *ruleSet) Apply(req *proto.ApplyRequest) (*proto.ApplyResponse, error) {
 	}
 
 	absPath := req.Path
-	protoFile := file.NewProtoFile(absPath, absPath)
-	p, err := protoFile.Parse(c.verbose)
+	f := file.NewProtoFile(absPath, absPath)
+	if 0 < len(req.Content) {
+		// Let the rule read and fix the content instead of the file at the path.
+		f = f.WithMemContent(req.Content)
+	}
+
+	p, err := f.Parse(c.verbose)
 	if err != nil {
 		return nil, err
 	}
 
 	fs, err := r.Apply(p)
+	file.Release(p)
 	if err != nil {
 		return nil, err
 	}
 	var fsp []*proto.ApplyResponse_Failure
 	for _, f := range fs {
-		fsp = append(fsp, &proto.ApplyResponse_Failure{
+		fp := &proto.ApplyResponse_Failure{
 			Message: f.Message(),
-			Pos: &proto.ApplyResponse_Position{
-				Offset: int32(f.Pos().Offset),
-				Line:   int32(f.Pos().Line),
-				Column: int32(f.Pos().Column),
-			},
-		})
+			Pos:     newPosition(f.Pos()),
+		}
+		if 0 < f.End().Line {
+			fp.End = newPosition(f.End())
+		}
+		for _, l := range f.SecondaryLocations() {
+			fp.SecondaryLocations = append(fp.SecondaryLocations, &proto.ApplyResponse_Location{
+				Message: l.Message,
+				Pos:     newPosition(l.Pos),
+			})
+		}
+		fsp = append(fsp, fp)
 	}
-	return &proto.ApplyResponse{
+	resp := &proto.ApplyResponse{
 		Failures: fsp,
-	}, nil
+	}
+	if f.IsInMemory() {
+		fixed, err := f.Content()
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(fixed, req.Content) {
+			resp.FixedContent = fixed
+		}
+	}
+	return resp, nil
+}
+
+func newPosition(pos meta.Position) *proto.ApplyResponse_Position {
+	return &proto.ApplyResponse_Position{
+		Offset: int32(pos.Offset),
+		Line:   int32(pos.Line),
+		Column: int32(pos.Column),
+	}
 }
@@ -0,0 +1,25 @@
+//go:build wasip1
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/yoheimuta/protolint/internal/addon/plugin/wasi"
+	"github.com/yoheimuta/protolint/linter/rule"
+)
+
+// RegisterCustomRules registers custom rules.
+//
+// The plugin built with GOOS=wasip1 GOARCH=wasm serves the rules over stdin and stdout.
+// protolint loads it in a sandbox, where it can't access the filesystem and the network.
+func RegisterCustomRules(
+	rules ...rule.Rule,
+) {
+	err := wasi.Serve(os.Stdin, os.Stdout, newRuleSet(rules))
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}